@@ -0,0 +1,158 @@
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	bboltPayloadBucket = []byte("payload")
+	bboltMetaBucket    = []byte("meta")
+)
+
+// BBolt is a persistent Cache backed by a single bbolt database file,
+// with one bucket for payload bytes and one for ETag/timestamp metadata.
+type BBolt struct {
+	db   *bolt.DB
+	opts options
+}
+
+// NewBBolt opens (creating if needed) a bbolt database at path and
+// returns a Cache backed by it.
+func NewBBolt(path string, opts ...Option) (*BBolt, error) {
+	db, err := bolt.Open(path, 0o644, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("cache: open bbolt db: %w", err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(bboltPayloadBucket); err != nil {
+			return err
+		}
+		_, err := tx.CreateBucketIfNotExists(bboltMetaBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("cache: init buckets: %w", err)
+	}
+
+	return &BBolt{db: db, opts: buildOptions(opts)}, nil
+}
+
+// Close releases the underlying bbolt database file.
+func (b *BBolt) Close() error {
+	return b.db.Close()
+}
+
+// Get implements celestrak.Cache.
+func (b *BBolt) Get(key string) (data []byte, etag string, ok bool) {
+	var meta entryMeta
+	err := b.db.Update(func(tx *bolt.Tx) error {
+		payload := tx.Bucket(bboltPayloadBucket).Get([]byte(key))
+		metaBytes := tx.Bucket(bboltMetaBucket).Get([]byte(key))
+		if payload == nil || metaBytes == nil {
+			return fmt.Errorf("not found")
+		}
+		if err := json.Unmarshal(metaBytes, &meta); err != nil {
+			return err
+		}
+
+		data = append([]byte(nil), payload...)
+		etag = meta.ETag
+
+		meta.LastAccess = time.Now()
+		touched, err := json.Marshal(meta)
+		if err != nil {
+			return err
+		}
+		return tx.Bucket(bboltMetaBucket).Put([]byte(key), touched)
+	})
+	if err != nil {
+		return nil, "", false
+	}
+	return data, etag, true
+}
+
+// Put implements celestrak.Cache.
+func (b *BBolt) Put(key string, data []byte, etag string) {
+	now := time.Now()
+	meta := entryMeta{ETag: etag, StoredAt: now, LastAccess: now, SizeBytes: int64(len(data))}
+	metaBytes, err := json.Marshal(meta)
+	if err != nil {
+		return
+	}
+
+	_ = b.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(bboltPayloadBucket).Put([]byte(key), data); err != nil {
+			return err
+		}
+		return tx.Bucket(bboltMetaBucket).Put([]byte(key), metaBytes)
+	})
+
+	b.evict()
+}
+
+// evict removes expired entries, then the least-recently-used remaining
+// ones until the database fits within maxBytes.
+func (b *BBolt) evict() {
+	if b.opts.maxAge <= 0 && b.opts.maxBytes <= 0 {
+		return
+	}
+
+	type keyInfo struct {
+		key  string
+		meta entryMeta
+	}
+
+	_ = b.db.Update(func(tx *bolt.Tx) error {
+		metaBucket := tx.Bucket(bboltMetaBucket)
+		payloadBucket := tx.Bucket(bboltPayloadBucket)
+
+		var toDelete [][]byte
+		var live []keyInfo
+		c := metaBucket.Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			var m entryMeta
+			if err := json.Unmarshal(v, &m); err != nil {
+				continue
+			}
+			if b.opts.expired(m) {
+				toDelete = append(toDelete, append([]byte(nil), k...))
+				continue
+			}
+			live = append(live, keyInfo{key: string(k), meta: m})
+		}
+
+		for _, k := range toDelete {
+			metaBucket.Delete(k)
+			payloadBucket.Delete(k)
+		}
+
+		if b.opts.maxBytes <= 0 {
+			return nil
+		}
+		var total int64
+		for _, ki := range live {
+			total += ki.meta.SizeBytes
+		}
+		if total <= b.opts.maxBytes {
+			return nil
+		}
+
+		sort.Slice(live, func(i, j int) bool { return live[i].meta.LastAccess.Before(live[j].meta.LastAccess) })
+		for _, ki := range live {
+			if total <= b.opts.maxBytes {
+				break
+			}
+			metaBucket.Delete([]byte(ki.key))
+			payloadBucket.Delete([]byte(ki.key))
+			total -= ki.meta.SizeBytes
+		}
+		return nil
+	})
+}
@@ -0,0 +1,57 @@
+// Package cache provides Cache implementations for celestrak.Client:
+// an in-memory LRU for tests and short-lived processes, and two
+// persistent, ETag-aware stores (filesystem and bbolt) for long-running
+// processes that want repeated fetches of the same group to come back as
+// cheap 304s. None of the implementations here import the celestrak
+// package - they satisfy its Cache interface structurally.
+package cache
+
+import "time"
+
+// entryMeta is the bookkeeping stored alongside each cached payload.
+// StoredAt drives TTL expiry; LastAccess drives LRU size eviction in
+// BBolt (FS uses the payload file's modification time instead).
+type entryMeta struct {
+	ETag       string
+	StoredAt   time.Time
+	LastAccess time.Time
+	SizeBytes  int64
+}
+
+// options configures the shared TTL/size-eviction policy for the
+// persistent cache implementations (FS and BBolt).
+type options struct {
+	maxAge   time.Duration // 0 = unbounded
+	maxBytes int64         // 0 = unbounded
+}
+
+// Option configures a persistent Cache implementation.
+type Option func(*options)
+
+// WithMaxAge bounds how long an unrefreshed entry is kept before it's
+// purged outright. It does not make Get reject a stale entry: Celestrak's
+// ETags mean every cached entry is still sent back to the server as an
+// If-None-Match conditional request, so staleness is normally resolved as
+// a cheap 304 rather than a cache miss. MaxAge only bounds disk usage for
+// entries that stop being requested.
+func WithMaxAge(d time.Duration) Option {
+	return func(o *options) { o.maxAge = d }
+}
+
+// WithMaxBytes bounds total cache size; once exceeded, the
+// least-recently-used entries are evicted until the cache fits again.
+func WithMaxBytes(n int64) Option {
+	return func(o *options) { o.maxBytes = n }
+}
+
+func buildOptions(opts []Option) options {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+func (o options) expired(m entryMeta) bool {
+	return o.maxAge > 0 && time.Since(m.StoredAt) > o.maxAge
+}
@@ -0,0 +1,141 @@
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMemoryLRUEviction(t *testing.T) {
+	m := NewMemory(2)
+	m.Put("a", []byte("1"), "etag-a")
+	m.Put("b", []byte("2"), "etag-b")
+
+	// Touch "a" so "b" becomes the least-recently-used entry.
+	if _, _, ok := m.Get("a"); !ok {
+		t.Fatalf("expected a to be cached")
+	}
+
+	m.Put("c", []byte("3"), "etag-c")
+
+	if _, _, ok := m.Get("b"); ok {
+		t.Errorf("expected b to be evicted as least-recently-used")
+	}
+	if _, _, ok := m.Get("a"); !ok {
+		t.Errorf("expected a to survive eviction")
+	}
+	if _, _, ok := m.Get("c"); !ok {
+		t.Errorf("expected c to be cached")
+	}
+}
+
+func TestMemoryPutOverwritesWithoutEvicting(t *testing.T) {
+	m := NewMemory(1)
+	m.Put("a", []byte("1"), "etag-1")
+	m.Put("a", []byte("2"), "etag-2")
+
+	data, etag, ok := m.Get("a")
+	if !ok {
+		t.Fatalf("expected a to be cached")
+	}
+	if string(data) != "2" || etag != "etag-2" {
+		t.Errorf("got data=%q etag=%q, want data=2 etag=etag-2", data, etag)
+	}
+}
+
+func TestOptionsExpired(t *testing.T) {
+	tests := []struct {
+		name    string
+		maxAge  time.Duration
+		age     time.Duration
+		expired bool
+	}{
+		{"unbounded never expires", 0, 10 * time.Hour, false},
+		{"fresh entry not expired", time.Hour, time.Minute, false},
+		{"stale entry expired", time.Hour, 2 * time.Hour, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := options{maxAge: tt.maxAge}
+			m := entryMeta{StoredAt: time.Now().Add(-tt.age)}
+			if got := o.expired(m); got != tt.expired {
+				t.Errorf("expired() = %v, want %v", got, tt.expired)
+			}
+		})
+	}
+}
+
+func TestFSGetPutRoundTrip(t *testing.T) {
+	fsCache, err := NewFS(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFS: %v", err)
+	}
+
+	if _, _, ok := fsCache.Get("missing"); ok {
+		t.Fatalf("expected a miss for an unset key")
+	}
+
+	fsCache.Put("key", []byte("body"), "etag-1")
+	data, etag, ok := fsCache.Get("key")
+	if !ok {
+		t.Fatalf("expected a hit after Put")
+	}
+	if string(data) != "body" || etag != "etag-1" {
+		t.Errorf("got data=%q etag=%q, want data=body etag=etag-1", data, etag)
+	}
+}
+
+func TestFSMaxAgeEvictsUnrefreshedEntries(t *testing.T) {
+	fsCache, err := NewFS(t.TempDir(), WithMaxAge(10*time.Millisecond))
+	if err != nil {
+		t.Fatalf("NewFS: %v", err)
+	}
+
+	fsCache.Put("stale", []byte("body"), "etag-1")
+	time.Sleep(20 * time.Millisecond)
+
+	// A second Put (e.g. after a fresh fetch for a different key) is what
+	// triggers the eviction sweep.
+	fsCache.Put("other", []byte("body2"), "etag-2")
+
+	if _, _, ok := fsCache.Get("stale"); ok {
+		t.Errorf("expected the unrefreshed entry to be evicted once past maxAge")
+	}
+}
+
+func TestFSPutRefreshesStoredAt(t *testing.T) {
+	// Mirrors the 304 path in celestrak.fetchOnce: Put is called again
+	// with the same bytes/etag purely to refresh StoredAt, which is what
+	// keeps an actively-revalidated entry from aging out under
+	// WithMaxAge.
+	fsCache, err := NewFS(t.TempDir(), WithMaxAge(20*time.Millisecond))
+	if err != nil {
+		t.Fatalf("NewFS: %v", err)
+	}
+
+	fsCache.Put("key", []byte("body"), "etag-1")
+	time.Sleep(15 * time.Millisecond)
+	fsCache.Put("key", []byte("body"), "etag-1") // refresh, as on a 304
+	time.Sleep(15 * time.Millisecond)
+	fsCache.Put("other", []byte("x"), "etag-x") // trigger an eviction sweep
+
+	if _, _, ok := fsCache.Get("key"); !ok {
+		t.Errorf("expected the refreshed entry to survive past the original maxAge window")
+	}
+}
+
+func TestBBoltGetPutRoundTrip(t *testing.T) {
+	b, err := NewBBolt(t.TempDir() + "/cache.db")
+	if err != nil {
+		t.Fatalf("NewBBolt: %v", err)
+	}
+	defer b.Close()
+
+	b.Put("key", []byte("body"), "etag-1")
+	data, etag, ok := b.Get("key")
+	if !ok {
+		t.Fatalf("expected a hit after Put")
+	}
+	if string(data) != "body" || etag != "etag-1" {
+		t.Errorf("got data=%q etag=%q, want data=body etag=etag-1", data, etag)
+	}
+}
@@ -0,0 +1,171 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// FS is a persistent Cache backed by a directory on disk: each key is
+// stored as a sibling pair of files, "<sha256(key)>.bin" for the payload
+// and "<sha256(key)>.etag" for the ETag/timestamp/size metadata. Put
+// writes to a temp file and renames it into place, so a crash mid-write
+// never leaves a corrupt entry visible to Get.
+type FS struct {
+	dir  string
+	opts options
+
+	mu sync.Mutex // serializes eviction sweeps
+}
+
+// NewFS creates (if needed) dir and returns an FS-backed Cache rooted
+// there.
+func NewFS(dir string, opts ...Option) (*FS, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("cache: create dir: %w", err)
+	}
+	return &FS{dir: dir, opts: buildOptions(opts)}, nil
+}
+
+func (f *FS) paths(key string) (dataPath, metaPath string) {
+	sum := sha256.Sum256([]byte(key))
+	name := hex.EncodeToString(sum[:])
+	return filepath.Join(f.dir, name+".bin"), filepath.Join(f.dir, name+".etag")
+}
+
+// Get implements celestrak.Cache.
+func (f *FS) Get(key string) (data []byte, etag string, ok bool) {
+	dataPath, metaPath := f.paths(key)
+
+	data, err := os.ReadFile(dataPath)
+	if err != nil {
+		return nil, "", false
+	}
+	meta, err := readMeta(metaPath)
+	if err != nil {
+		return nil, "", false
+	}
+
+	_ = os.Chtimes(dataPath, time.Now(), time.Now()) // mark as recently used for LRU eviction
+	return data, meta.ETag, true
+}
+
+// Put implements celestrak.Cache.
+func (f *FS) Put(key string, data []byte, etag string) {
+	dataPath, metaPath := f.paths(key)
+	now := time.Now()
+	meta := entryMeta{ETag: etag, StoredAt: now, LastAccess: now, SizeBytes: int64(len(data))}
+
+	if err := writeAtomic(dataPath, data); err != nil {
+		return
+	}
+	if err := writeMeta(metaPath, meta); err != nil {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.evictLocked()
+}
+
+func readMeta(path string) (entryMeta, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return entryMeta{}, err
+	}
+	var m entryMeta
+	if err := json.Unmarshal(b, &m); err != nil {
+		return entryMeta{}, err
+	}
+	return m, nil
+}
+
+func writeMeta(path string, m entryMeta) error {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return writeAtomic(path, b)
+}
+
+func writeAtomic(path string, data []byte) error {
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// evictLocked removes expired entries, then the least-recently-used
+// remaining ones until the cache fits within maxBytes. Must be called
+// with f.mu held.
+func (f *FS) evictLocked() {
+	if f.opts.maxAge <= 0 && f.opts.maxBytes <= 0 {
+		return
+	}
+
+	entries, err := os.ReadDir(f.dir)
+	if err != nil {
+		return
+	}
+
+	type fileInfo struct {
+		dataPath, metaPath string
+		size               int64
+		mtime              time.Time
+		meta               entryMeta
+	}
+	var files []fileInfo
+	for _, e := range entries {
+		name := e.Name()
+		if filepath.Ext(name) != ".bin" {
+			continue
+		}
+		base := name[:len(name)-len(".bin")]
+		dataPath := filepath.Join(f.dir, name)
+		metaPath := filepath.Join(f.dir, base+".etag")
+
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		meta, err := readMeta(metaPath)
+		if err != nil {
+			continue
+		}
+
+		if f.opts.expired(meta) {
+			os.Remove(dataPath)
+			os.Remove(metaPath)
+			continue
+		}
+		files = append(files, fileInfo{dataPath, metaPath, info.Size(), info.ModTime(), meta})
+	}
+
+	if f.opts.maxBytes <= 0 {
+		return
+	}
+	var total int64
+	for _, fi := range files {
+		total += fi.size
+	}
+	if total <= f.opts.maxBytes {
+		return
+	}
+
+	sort.Slice(files, func(i, j int) bool { return files[i].mtime.Before(files[j].mtime) })
+	for _, fi := range files {
+		if total <= f.opts.maxBytes {
+			break
+		}
+		os.Remove(fi.dataPath)
+		os.Remove(fi.metaPath)
+		total -= fi.size
+	}
+}
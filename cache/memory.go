@@ -0,0 +1,70 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+)
+
+// Memory is a concurrency-safe, in-memory LRU cache, useful for tests
+// and short-lived processes that don't want to touch disk.
+type Memory struct {
+	mu         sync.Mutex
+	maxEntries int
+	ll         *list.List // front = most recently used
+	index      map[string]*list.Element
+}
+
+type memoryEntry struct {
+	key  string
+	data []byte
+	etag string
+}
+
+// NewMemory creates an in-memory cache that holds at most maxEntries
+// entries, evicting the least-recently-used one on overflow.
+func NewMemory(maxEntries int) *Memory {
+	return &Memory{
+		maxEntries: maxEntries,
+		ll:         list.New(),
+		index:      make(map[string]*list.Element),
+	}
+}
+
+// Get implements celestrak.Cache.
+func (m *Memory) Get(key string) (data []byte, etag string, ok bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	el, found := m.index[key]
+	if !found {
+		return nil, "", false
+	}
+	m.ll.MoveToFront(el)
+	e := el.Value.(*memoryEntry)
+	return e.data, e.etag, true
+}
+
+// Put implements celestrak.Cache.
+func (m *Memory) Put(key string, data []byte, etag string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if el, found := m.index[key]; found {
+		m.ll.MoveToFront(el)
+		e := el.Value.(*memoryEntry)
+		e.data, e.etag = data, etag
+		return
+	}
+
+	el := m.ll.PushFront(&memoryEntry{key: key, data: data, etag: etag})
+	m.index[key] = el
+
+	for m.maxEntries > 0 && m.ll.Len() > m.maxEntries {
+		oldest := m.ll.Back()
+		if oldest == nil {
+			break
+		}
+		m.ll.Remove(oldest)
+		delete(m.index, oldest.Value.(*memoryEntry).key)
+	}
+}
@@ -0,0 +1,119 @@
+package celestrak
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/avyayk/celestrak-go/parse"
+)
+
+// BatchResult is the outcome of fetching one Query as part of a
+// FetchGPBatch call: the raw bytes, the best-effort parse of them, and
+// any error either step produced. A non-nil Err with non-nil Data means
+// the fetch succeeded but parsing some or all records failed; GP then
+// holds whichever records did parse, and is nil if none did (see
+// parse.MultiError). A non-nil Err with nil Data means the fetch itself
+// failed.
+type BatchResult struct {
+	Query Query
+	Data  []byte
+	GP    []parse.GP
+	Err   error
+}
+
+type batchConfig struct {
+	concurrency       int
+	perRequestTimeout time.Duration
+	failFast          bool
+}
+
+// BatchOption configures FetchGPBatch.
+type BatchOption func(*batchConfig)
+
+// WithConcurrency bounds how many requests FetchGPBatch issues at once.
+// Defaults to 4.
+func WithConcurrency(n int) BatchOption {
+	return func(c *batchConfig) { c.concurrency = n }
+}
+
+// WithPerRequestTimeout applies a timeout to each individual fetch,
+// independent of the context passed to FetchGPBatch.
+func WithPerRequestTimeout(d time.Duration) BatchOption {
+	return func(c *batchConfig) { c.perRequestTimeout = d }
+}
+
+// WithFailFast makes FetchGPBatch return an error once any query fails,
+// instead of only reporting failures per result. The first failure
+// cancels the context shared by the batch: queries already in flight are
+// aborted and un-started ones fail at once, each with a context error in
+// its BatchResult.
+func WithFailFast(failFast bool) BatchOption {
+	return func(c *batchConfig) { c.failFast = failFast }
+}
+
+// FetchGPBatch fetches GP data for many queries concurrently, bounded by
+// WithConcurrency, with identical in-flight queries collapsed into a
+// single HTTP round-trip via singleflight. Results are returned in the
+// same order as queries, one BatchResult per query, regardless of
+// individual failures - check BatchResult.Err rather than the returned
+// error for per-query outcomes. The returned error is non-nil only when
+// WithFailFast is set and at least one query failed.
+func (c *Client) FetchGPBatch(ctx context.Context, queries []Query, opts ...BatchOption) ([]BatchResult, error) {
+	cfg := batchConfig{concurrency: 4}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cfg.concurrency <= 0 {
+		cfg.concurrency = 4
+	}
+
+	results := make([]BatchResult, len(queries))
+	var sf singleflight.Group
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(cfg.concurrency)
+
+	for i, q := range queries {
+		i, q := i, q
+		g.Go(func() error {
+			results[i] = c.fetchBatchOne(gctx, q, cfg, &sf)
+			if cfg.failFast && results[i].Err != nil {
+				return results[i].Err
+			}
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil && cfg.failFast {
+		return results, err
+	}
+	return results, nil
+}
+
+func (c *Client) fetchBatchOne(ctx context.Context, q Query, cfg batchConfig, sf *singleflight.Group) BatchResult {
+	reqCtx := ctx
+	if cfg.perRequestTimeout > 0 {
+		var cancel context.CancelFunc
+		reqCtx, cancel = context.WithTimeout(ctx, cfg.perRequestTimeout)
+		defer cancel()
+	}
+
+	sfKey, err := q.BuildURL(c.baseURL, "gp.php")
+	if err != nil {
+		return BatchResult{Query: q, Err: err}
+	}
+
+	v, err, _ := sf.Do(sfKey, func() (any, error) {
+		return c.FetchGP(reqCtx, q)
+	})
+	if err != nil {
+		return BatchResult{Query: q, Err: err}
+	}
+
+	data := v.([]byte)
+	gps, parseErr := parseGP(q.FORMAT, data)
+	return BatchResult{Query: q, Data: data, GP: gps, Err: parseErr}
+}
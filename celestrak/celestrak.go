@@ -7,7 +7,11 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type Format string
@@ -43,6 +47,15 @@ type Client struct {
 	// Retry configuration
 	maxRetries int           // Maximum number of retries (default: 3)
 	retryDelay time.Duration // Initial retry delay (default: 1s)
+	jitter     bool          // Whether to jitter the exponential backoff (default: false)
+
+	rateLimiter RateLimiter // Optional rate limiter applied before every HTTP request
+
+	// Observability (both optional; default to no-op providers)
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+	instr          *instruments
+	instrOnce      sync.Once
 }
 
 func NewClient(httpClient *http.Client) (*Client, error) {
@@ -84,6 +97,21 @@ func (c *Client) WithUserAgent(ua string) *Client {
 	return c
 }
 
+// WithJitter enables jitter on the exponential backoff between retries,
+// which avoids a thundering herd when many goroutines share one Client.
+func (c *Client) WithJitter(enabled bool) *Client {
+	c.jitter = enabled
+	return c
+}
+
+// WithRateLimiter sets a rate limiter that fetchOnce waits on before issuing
+// every HTTP request, e.g. a golang.org/x/time/rate.Limiter shared across
+// goroutines to stay within Celestrak's access policy.
+func (c *Client) WithRateLimiter(rl RateLimiter) *Client {
+	c.rateLimiter = rl
+	return c
+}
+
 const (
 	// maxResponseSize limits response size to 100MB to prevent memory exhaustion
 	maxResponseSize = 100 * 1024 * 1024
@@ -103,9 +131,9 @@ func shouldRetry(err error) bool {
 	// Retry on network errors (connection refused, timeout, etc.)
 	// These are typically transient
 
-	// Check for server errors (5xx) - these are retryable
+	// Check for server errors (5xx) and rate limiting (429) - these are retryable
 	if errResp, ok := err.(*ErrorResponse); ok {
-		return errResp.IsServerError()
+		return errResp.IsServerError() || errResp.IsRateLimit()
 	}
 
 	// Retry on context deadline exceeded (might be transient network issue)
@@ -152,6 +180,12 @@ func (c *Client) fetchOnce(ctx context.Context, q Query, endpoint string) ([]byt
 		req.Header.Set("If-None-Match", etag)
 	}
 
+	if c.rateLimiter != nil {
+		if err := c.rateLimiter.Wait(ctx); err != nil {
+			return nil, fmt.Errorf("rate limiter: %w", err)
+		}
+	}
+
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		// Check if error is due to context cancellation/timeout
@@ -165,6 +199,14 @@ func (c *Client) fetchOnce(ctx context.Context, q Query, endpoint string) ([]byt
 	// 304: use cached body
 	if resp.StatusCode == http.StatusNotModified {
 		if hasCache {
+			if c.cache != nil {
+				// Refresh the cache's timestamp so TTL-based eviction
+				// doesn't purge an entry the server just confirmed is
+				// still current.
+				c.cache.Put(cacheKey, cached, etag)
+			}
+			markCacheHit(ctx)
+			c.recordCacheHit(ctx, endpoint)
 			return cached, nil
 		}
 		// If server says not modified but we don't have cached bytes, treat as error.
@@ -235,16 +277,28 @@ func (c *Client) fetch(ctx context.Context, q Query, endpoint string) ([]byte, e
 
 		// Wait before retry (skip on first attempt)
 		if attempt > 0 {
+			wait := delay
+			if c.jitter {
+				wait = withJitter(wait)
+			}
+			// Honor the server's Retry-After guidance when it asks for
+			// longer than our own backoff would.
+			if retryAfter, ok := retryAfterFromError(lastErr); ok && retryAfter > wait {
+				wait = retryAfter
+			}
+
 			select {
 			case <-ctx.Done():
 				return nil, fmt.Errorf("context cancelled during retry: %w", ctx.Err())
-			case <-time.After(delay):
+			case <-time.After(wait):
 				// Exponential backoff: double the delay each time
 				delay *= 2
 			}
 		}
 
-		data, err := c.fetchOnce(ctx, q, endpoint)
+		data, err := c.fetchTelemetry(ctx, q, endpoint, attempt, func(spanCtx context.Context) ([]byte, error) {
+			return c.fetchOnce(spanCtx, q, endpoint)
+		})
 		if err == nil {
 			return data, nil
 		}
@@ -255,8 +309,38 @@ func (c *Client) fetch(ctx context.Context, q Query, endpoint string) ([]byte, e
 		if !shouldRetry(err) {
 			return nil, err
 		}
+		if attempt < c.maxRetries {
+			c.recordRetry(ctx, retryReason(err))
+		}
 	}
 
 	// All retries exhausted
 	return nil, fmt.Errorf("max retries (%d) exceeded: %w", c.maxRetries, lastErr)
 }
+
+// retryAfterFromError extracts a Retry-After duration from a 429 or 503
+// ErrorResponse, if present.
+func retryAfterFromError(err error) (time.Duration, bool) {
+	errResp, ok := err.(*ErrorResponse)
+	if !ok || errResp.Response == nil {
+		return 0, false
+	}
+	if !errResp.IsRateLimit() && errResp.Response.StatusCode != http.StatusServiceUnavailable {
+		return 0, false
+	}
+	return parseRetryAfter(errResp.Response.Header)
+}
+
+// retryReason classifies why a fetch is being retried, for the
+// celestrak_retries_total{reason} counter.
+func retryReason(err error) string {
+	if errResp, ok := err.(*ErrorResponse); ok {
+		switch {
+		case errResp.IsRateLimit():
+			return "rate_limited"
+		case errResp.IsServerError():
+			return "server_error"
+		}
+	}
+	return "network"
+}
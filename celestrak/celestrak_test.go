@@ -0,0 +1,123 @@
+package celestrak
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// recordingCache is a minimal celestrak.Cache that timestamps every Put,
+// so tests can tell whether a 304 response refreshed an existing entry.
+type recordingCache struct {
+	mu       sync.Mutex
+	data     []byte
+	etag     string
+	ok       bool
+	putCalls int
+	lastPut  time.Time
+}
+
+func (c *recordingCache) Get(key string) ([]byte, string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.data, c.etag, c.ok
+}
+
+func (c *recordingCache) Put(key string, data []byte, etag string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.data, c.etag, c.ok = data, etag, true
+	c.putCalls++
+	c.lastPut = time.Now()
+}
+
+func newTestClient(t *testing.T, srv *httptest.Server) *Client {
+	t.Helper()
+	c, err := NewClient(srv.Client())
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	u, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatalf("parse test server URL: %v", err)
+	}
+	c.baseURL = u
+	return c
+}
+
+// TestFetchOnce304RefreshesCache confirms that a 304 response touches the
+// cache via Put, refreshing entryMeta.StoredAt - without this, an entry
+// that's actively revalidated via ETag would still be purged by
+// cache.WithMaxAge once it aged out, contradicting that option's doc
+// comment.
+func TestFetchOnce304RefreshesCache(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		t.Fatalf("expected conditional request with If-None-Match, got %q", r.Header.Get("If-None-Match"))
+	}))
+	defer srv.Close()
+
+	cache := &recordingCache{data: []byte("cached-body"), etag: `"v1"`, ok: true}
+	c := newTestClient(t, srv).WithCache(cache)
+
+	data, err := c.fetchOnce(context.Background(), Query{GROUP: "stations"}, "gp.php")
+	if err != nil {
+		t.Fatalf("fetchOnce: %v", err)
+	}
+	if string(data) != "cached-body" {
+		t.Fatalf("got %q, want cached-body", data)
+	}
+	if cache.putCalls != 1 {
+		t.Fatalf("Put called %d times on 304, want 1 (StoredAt refresh)", cache.putCalls)
+	}
+}
+
+// TestFetchTelemetryCacheHitAttribute confirms a 304 is visible on the
+// celestrak.fetch span as an explicit cache.hit attribute, not just via
+// the separate cache-hit counter.
+func TestFetchTelemetryCacheHitAttribute(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotModified)
+	}))
+	defer srv.Close()
+
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	cache := &recordingCache{data: []byte("cached-body"), etag: `"v1"`, ok: true}
+	c := newTestClient(t, srv).WithCache(cache).WithTracer(tp)
+
+	_, err := c.fetchTelemetry(context.Background(), Query{GROUP: "stations"}, "gp.php", 0, func(ctx context.Context) ([]byte, error) {
+		return c.fetchOnce(ctx, Query{GROUP: "stations"}, "gp.php")
+	})
+	if err != nil {
+		t.Fatalf("fetchTelemetry: %v", err)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("got %d ended spans, want 1", len(spans))
+	}
+	var found bool
+	for _, kv := range spans[0].Attributes() {
+		if kv.Key == "cache.hit" {
+			found = true
+			if !kv.Value.AsBool() {
+				t.Fatalf("cache.hit = false, want true for a 304 response")
+			}
+		}
+	}
+	if !found {
+		t.Fatalf("span missing cache.hit attribute: %v", spans[0].Attributes())
+	}
+}
@@ -0,0 +1,46 @@
+// Package otel wires up the OTel SDK and exporters for celestrak.Client's
+// WithTracer/WithMeter hooks. It's a separate package from celestrak
+// itself so that importing celestrak never compiles in the SDK or an
+// exporter - only users who want real tracing/metrics import this
+// package.
+package otel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+	otelprometheus "go.opentelemetry.io/otel/exporters/prometheus"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// NewPrometheusMeterProvider returns a metric.MeterProvider that exposes
+// celestrak's counters/histograms/gauge to reg, ready to pass to
+// celestrak.Client.WithMeter. Scrape it the usual way, e.g. with
+// promhttp.HandlerFor(reg, promhttp.HandlerOpts{}).
+func NewPrometheusMeterProvider(reg *prometheus.Registry) (metric.MeterProvider, error) {
+	exporter, err := otelprometheus.New(otelprometheus.WithRegisterer(reg))
+	if err != nil {
+		return nil, fmt.Errorf("otel: create prometheus exporter: %w", err)
+	}
+	return sdkmetric.NewMeterProvider(sdkmetric.WithReader(exporter)), nil
+}
+
+// NewTracerProvider returns a trace.TracerProvider that exports every
+// "celestrak.fetch" span via exporter (e.g. an OTLP or stdout exporter),
+// ready to pass to celestrak.Client.WithTracer. Call Shutdown on the
+// returned provider during application shutdown to flush pending spans.
+func NewTracerProvider(exporter sdktrace.SpanExporter) *sdktrace.TracerProvider {
+	return sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter))
+}
+
+// Shutdown is a convenience wrapper for flushing and closing a
+// TracerProvider created by NewTracerProvider.
+func Shutdown(ctx context.Context, tp *sdktrace.TracerProvider) error {
+	return tp.Shutdown(ctx)
+}
+
+var _ trace.TracerProvider = (*sdktrace.TracerProvider)(nil)
@@ -0,0 +1,39 @@
+package celestrak
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/avyayk/celestrak-go/parse"
+)
+
+// FetchGPParsed fetches GP data like FetchGP, then parses the response
+// into typed GP structs based on q.FORMAT. Individual malformed records
+// don't fail the whole call: if some records parse and others don't, the
+// good ones are returned alongside a *parse.MultiError describing the rest.
+func (c *Client) FetchGPParsed(ctx context.Context, q Query) ([]parse.GP, error) {
+	data, err := c.FetchGP(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	return parseGP(q.FORMAT, data)
+}
+
+func parseGP(format Format, data []byte) ([]parse.GP, error) {
+	switch format {
+	case "", FormatTLE, Format3LE:
+		return parse.ParseTLE(data)
+	case Format2LE:
+		return parse.Parse2LE(data)
+	case FormatJSON, FormatJSONPretty:
+		return parse.ParseOMMJSON(data)
+	case FormatXML:
+		return parse.ParseOMMXML(data)
+	case FormatKVN:
+		return parse.ParseOMMKVN(data)
+	case FormatCSV:
+		return parse.ParseCSV(data)
+	default:
+		return nil, &QueryError{Message: fmt.Sprintf("no parser for FORMAT %q", format)}
+	}
+}
@@ -0,0 +1,56 @@
+package celestrak
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RateLimiter throttles outgoing requests. Implementations should block in
+// Wait until the caller is permitted to proceed, or return ctx.Err() if the
+// context is done first. golang.org/x/time/rate.Limiter satisfies this
+// interface via its Wait method.
+type RateLimiter interface {
+	Wait(ctx context.Context) error
+}
+
+// parseRetryAfter parses a Retry-After header value, supporting both the
+// delta-seconds form ("120") and the HTTP-date form
+// ("Fri, 31 Dec 1999 23:59:59 GMT"). It returns false if the header is
+// absent or unparseable.
+func parseRetryAfter(h http.Header) (time.Duration, bool) {
+	v := h.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+
+	return 0, false
+}
+
+// withJitter applies "equal jitter" to d: half the delay is kept fixed and
+// the other half is randomized, which avoids a thundering herd when many
+// goroutines share one Client without collapsing the backoff to zero.
+func withJitter(d time.Duration) time.Duration {
+	if d <= 0 {
+		return d
+	}
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
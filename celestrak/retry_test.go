@@ -0,0 +1,64 @@
+package celestrak
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	future := time.Now().Add(90 * time.Second).UTC().Format(http.TimeFormat)
+
+	tests := []struct {
+		name    string
+		header  string
+		wantOK  bool
+		wantMin time.Duration
+		wantMax time.Duration
+	}{
+		{"delta seconds", "120", true, 120 * time.Second, 120 * time.Second},
+		{"zero seconds", "0", true, 0, 0},
+		{"negative seconds rejected", "-5", false, 0, 0},
+		{"missing header", "", false, 0, 0},
+		{"garbage value", "not-a-duration", false, 0, 0},
+		{"HTTP-date in the future", future, true, 85 * time.Second, 95 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := http.Header{}
+			if tt.header != "" {
+				h.Set("Retry-After", tt.header)
+			}
+			got, ok := parseRetryAfter(h)
+			if ok != tt.wantOK {
+				t.Fatalf("parseRetryAfter(%q) ok = %v, want %v", tt.header, ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if got < tt.wantMin || got > tt.wantMax {
+				t.Errorf("parseRetryAfter(%q) = %v, want in [%v, %v]", tt.header, got, tt.wantMin, tt.wantMax)
+			}
+		})
+	}
+}
+
+func TestWithJitterStaysWithinBounds(t *testing.T) {
+	d := 10 * time.Second
+	for i := 0; i < 100; i++ {
+		got := withJitter(d)
+		if got < d/2 || got > d {
+			t.Fatalf("withJitter(%v) = %v, want in [%v, %v]", d, got, d/2, d)
+		}
+	}
+}
+
+func TestWithJitterNonPositive(t *testing.T) {
+	if got := withJitter(0); got != 0 {
+		t.Errorf("withJitter(0) = %v, want 0", got)
+	}
+	if got := withJitter(-time.Second); got != -time.Second {
+		t.Errorf("withJitter(-1s) = %v, want -1s", got)
+	}
+}
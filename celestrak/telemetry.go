@@ -0,0 +1,184 @@
+package celestrak
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/trace"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+)
+
+// instrumentationName identifies this package to OTel as the tracer/meter
+// name, following the convention of using the instrumented package's
+// import path.
+const instrumentationName = "github.com/avyayk/celestrak-go/celestrak"
+
+// instruments bundles the metric instruments recorded on every fetch.
+// Built once per Client from whichever MeterProvider is configured.
+type instruments struct {
+	requestsTotal   metric.Int64Counter
+	cacheHitsTotal  metric.Int64Counter
+	retriesTotal    metric.Int64Counter
+	requestDuration metric.Float64Histogram
+	responseSize    metric.Int64Histogram
+	inFlight        metric.Int64UpDownCounter
+}
+
+// WithTracer sets the OTel TracerProvider used to emit a
+// "celestrak.fetch" span around every request. Defaults to a no-op
+// provider, so importing celestrak never pulls in an OTel SDK - only its
+// lightweight API package.
+func (c *Client) WithTracer(tp trace.TracerProvider) *Client {
+	c.tracerProvider = tp
+	return c
+}
+
+// WithMeter sets the OTel MeterProvider used to record request counters,
+// duration/size histograms, and an in-flight gauge. Defaults to a no-op
+// provider. See the celestrak/otel subpackage for a ready-made Prometheus
+// MeterProvider.
+func (c *Client) WithMeter(mp metric.MeterProvider) *Client {
+	c.meterProvider = mp
+	c.instr = nil
+	c.instrOnce = sync.Once{}
+	return c
+}
+
+func (c *Client) tracer() trace.Tracer {
+	tp := c.tracerProvider
+	if tp == nil {
+		tp = tracenoop.NewTracerProvider()
+	}
+	return tp.Tracer(instrumentationName)
+}
+
+func (c *Client) instruments() *instruments {
+	c.instrOnce.Do(func() {
+		mp := c.meterProvider
+		if mp == nil {
+			mp = metricnoop.NewMeterProvider()
+		}
+		m := mp.Meter(instrumentationName)
+
+		requestsTotal, _ := m.Int64Counter("celestrak_requests_total",
+			metric.WithDescription("GP/table fetches, by endpoint/status/format"))
+		cacheHitsTotal, _ := m.Int64Counter("celestrak_cache_hits_total",
+			metric.WithDescription("Fetches served from cache via a 304"))
+		retriesTotal, _ := m.Int64Counter("celestrak_retries_total",
+			metric.WithDescription("Retry attempts, by reason"))
+		requestDuration, _ := m.Float64Histogram("celestrak_request_duration_seconds",
+			metric.WithDescription("Fetch latency in seconds"), metric.WithUnit("s"))
+		responseSize, _ := m.Int64Histogram("celestrak_response_size_bytes",
+			metric.WithDescription("Response body size in bytes"), metric.WithUnit("By"))
+		inFlight, _ := m.Int64UpDownCounter("celestrak_inflight_requests",
+			metric.WithDescription("Requests currently in flight"))
+
+		c.instr = &instruments{
+			requestsTotal:   requestsTotal,
+			cacheHitsTotal:  cacheHitsTotal,
+			retriesTotal:    retriesTotal,
+			requestDuration: requestDuration,
+			responseSize:    responseSize,
+			inFlight:        inFlight,
+		}
+	})
+	return c.instr
+}
+
+// cacheHitKey is the context key fetchTelemetry uses to learn from
+// fetchOnce whether a request was served from cache via a 304, so the
+// celestrak.fetch span can carry that as an explicit attribute rather
+// than only the separate cache-hit counter.
+type cacheHitKey struct{}
+
+// markCacheHit records on ctx that the in-flight fetch was served from
+// cache. A no-op if ctx wasn't set up by fetchTelemetry to track this.
+func markCacheHit(ctx context.Context) {
+	if hit, ok := ctx.Value(cacheHitKey{}).(*bool); ok {
+		*hit = true
+	}
+}
+
+// selectorKind reports which of CATNR/INTDES/GROUP/NAME/SPECIAL a Query
+// used, for the span/metric attributes; "" if none is set.
+func (q Query) selectorKind() string {
+	switch {
+	case q.CATNR != "":
+		return "CATNR"
+	case q.INTDES != "":
+		return "INTDES"
+	case q.GROUP != "":
+		return "GROUP"
+	case q.NAME != "":
+		return "NAME"
+	case q.SPECIAL != "":
+		return "SPECIAL"
+	default:
+		return ""
+	}
+}
+
+// fetchTelemetry wraps one fetchOnce call with a span and the associated
+// metrics: in-flight gauge, request duration/size histograms, and the
+// requests counter. The cache-hit counter is recorded by fetchOnce itself.
+func (c *Client) fetchTelemetry(ctx context.Context, q Query, endpoint string, attempt int, do func(context.Context) ([]byte, error)) ([]byte, error) {
+	instr := c.instruments()
+	format := string(q.FORMAT)
+	if format == "" {
+		format = string(FormatTLE)
+	}
+	attrs := []attribute.KeyValue{
+		attribute.String("endpoint", endpoint),
+		attribute.String("selector", q.selectorKind()),
+		attribute.String("format", format),
+	}
+
+	ctx, span := c.tracer().Start(ctx, "celestrak.fetch")
+	span.SetAttributes(attrs...)
+	span.SetAttributes(attribute.Int("retry.attempt", attempt))
+
+	instr.inFlight.Add(ctx, 1, metric.WithAttributes(attrs...))
+	start := time.Now()
+
+	var cacheHit bool
+	ctx = context.WithValue(ctx, cacheHitKey{}, &cacheHit)
+	data, err := do(ctx)
+
+	instr.inFlight.Add(ctx, -1, metric.WithAttributes(attrs...))
+	instr.requestDuration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(attrs...))
+
+	status := "error"
+	if errResp, ok := err.(*ErrorResponse); ok && errResp.Response != nil {
+		status = strconv.Itoa(errResp.Response.StatusCode)
+	} else if err == nil {
+		status = "200"
+	}
+	statusAttrs := append(append([]attribute.KeyValue{}, attrs...), attribute.String("status", status))
+	instr.requestsTotal.Add(ctx, 1, metric.WithAttributes(statusAttrs...))
+
+	if err == nil {
+		instr.responseSize.Record(ctx, int64(len(data)), metric.WithAttributes(attrs...))
+	}
+
+	span.SetAttributes(
+		attribute.String("status", status),
+		attribute.Int("response.size", len(data)),
+		attribute.Bool("cache.hit", cacheHit),
+	)
+	span.End()
+
+	return data, err
+}
+
+func (c *Client) recordCacheHit(ctx context.Context, endpoint string) {
+	c.instruments().cacheHitsTotal.Add(ctx, 1, metric.WithAttributes(attribute.String("endpoint", endpoint)))
+}
+
+func (c *Client) recordRetry(ctx context.Context, reason string) {
+	c.instruments().retriesTotal.Add(ctx, 1, metric.WithAttributes(attribute.String("reason", reason)))
+}
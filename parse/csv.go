@@ -0,0 +1,112 @@
+package parse
+
+import (
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseCSV parses OMM records served as FORMAT=CSV, mapping columns by
+// their header-row names rather than a fixed position so the parser keeps
+// working if Celestrak reorders or adds columns.
+func ParseCSV(data []byte) ([]GP, error) {
+	r := csv.NewReader(strings.NewReader(string(data)))
+	rows, err := r.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("decode CSV: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	col := map[string]int{}
+	for i, name := range rows[0] {
+		col[strings.TrimSpace(name)] = i
+	}
+
+	var gps []GP
+	var errs []error
+	for i, row := range rows[1:] {
+		field := func(name string) string {
+			idx, ok := col[name]
+			if !ok || idx >= len(row) {
+				return ""
+			}
+			return strings.TrimSpace(row[idx])
+		}
+
+		epoch, err := parseOMMEpoch(field("EPOCH"))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("record %d: epoch: %w", i, err))
+			continue
+		}
+
+		gp := GP{
+			OBJECT_NAME:        field("OBJECT_NAME"),
+			OBJECT_ID:          field("OBJECT_ID"),
+			EPOCH:              epoch,
+			ClassificationType: field("CLASSIFICATION_TYPE"),
+		}
+
+		if err := setCSVFloats(&gp, field); err != nil {
+			errs = append(errs, fmt.Errorf("record %d: %w", i, err))
+			continue
+		}
+		if err := setCSVInts(&gp, field); err != nil {
+			errs = append(errs, fmt.Errorf("record %d: %w", i, err))
+			continue
+		}
+
+		gps = append(gps, gp)
+	}
+
+	if len(errs) > 0 {
+		return gps, &MultiError{Errs: errs}
+	}
+	return gps, nil
+}
+
+func setCSVFloats(gp *GP, field func(string) string) error {
+	for name, dst := range map[string]*float64{
+		"MEAN_MOTION":       &gp.MeanMotion,
+		"ECCENTRICITY":      &gp.Eccentricity,
+		"INCLINATION":       &gp.Inclination,
+		"RA_OF_ASC_NODE":    &gp.RAAN,
+		"ARG_OF_PERICENTER": &gp.ArgOfPericenter,
+		"MEAN_ANOMALY":      &gp.MeanAnomaly,
+		"BSTAR":             &gp.BSTAR,
+		"MEAN_MOTION_DOT":   &gp.MeanMotionDot,
+		"MEAN_MOTION_DDOT":  &gp.MeanMotionDDot,
+	} {
+		v := field(name)
+		if v == "" {
+			continue
+		}
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		*dst = f
+	}
+	return nil
+}
+
+func setCSVInts(gp *GP, field func(string) string) error {
+	for name, dst := range map[string]*int{
+		"NORAD_CAT_ID":   &gp.NORAD_CAT_ID,
+		"ELEMENT_SET_NO": &gp.ElementSetNo,
+		"REV_AT_EPOCH":   &gp.RevAtEpoch,
+	} {
+		v := field(name)
+		if v == "" {
+			continue
+		}
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		*dst = n
+	}
+	return nil
+}
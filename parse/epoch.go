@@ -0,0 +1,12 @@
+package parse
+
+import "time"
+
+// ommEpochLayout matches the EPOCH timestamps Celestrak emits in OMM
+// JSON/XML/KVN, e.g. "2024-01-01T12:34:56.789012" (no trailing "Z",
+// fractional seconds of varying precision).
+const ommEpochLayout = "2006-01-02T15:04:05.999999"
+
+func parseOMMEpoch(s string) (time.Time, error) {
+	return time.Parse(ommEpochLayout, s)
+}
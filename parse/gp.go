@@ -0,0 +1,58 @@
+// Package parse converts the raw GP (General Perturbations) payloads
+// returned by Celestrak into typed Go structs, covering every format the
+// API can serve: TLE/2LE/3LE text, OMM JSON, OMM XML, OMM KVN, and CSV.
+package parse
+
+import (
+	"strconv"
+	"time"
+)
+
+// GP is a General Perturbations element set, normalized from whichever
+// wire format it was parsed from. OBJECT_NAME, OBJECT_ID, EPOCH and
+// NORAD_CAT_ID keep the CCSDS OMM key names Celestrak uses in its
+// JSON/XML/KVN output; the other fields use Go-style names.
+type GP struct {
+	OBJECT_NAME string
+	OBJECT_ID   string
+	EPOCH       time.Time
+
+	MeanMotion      float64 // revs/day
+	Eccentricity    float64
+	Inclination     float64 // degrees
+	RAAN            float64 // degrees, right ascension of ascending node
+	ArgOfPericenter float64 // degrees
+	MeanAnomaly     float64 // degrees
+
+	BSTAR          float64 // earth radii^-1
+	MeanMotionDot  float64 // revs/day^2, first time derivative of mean motion divided by 2
+	MeanMotionDDot float64 // revs/day^3, second time derivative of mean motion divided by 6
+
+	NORAD_CAT_ID       int
+	ClassificationType string // "U", "C", or "S"
+	ElementSetNo       int
+	RevAtEpoch         int
+}
+
+// MultiError collects per-record errors encountered while parsing a batch
+// that otherwise produced usable results. Callers that only care whether
+// parsing succeeded at all can still check len(m.Errs) == 0.
+type MultiError struct {
+	Errs []error
+}
+
+func (m *MultiError) Error() string {
+	if len(m.Errs) == 1 {
+		return m.Errs[0].Error()
+	}
+	s := "parse: " + strconv.Itoa(len(m.Errs)) + " of the records failed:"
+	for _, e := range m.Errs {
+		s += "\n  - " + e.Error()
+	}
+	return s
+}
+
+// Unwrap supports errors.Is/As over every underlying record error.
+func (m *MultiError) Unwrap() []error {
+	return m.Errs
+}
@@ -0,0 +1,69 @@
+package parse
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// jsonGP mirrors the OMM JSON record shape Celestrak serves for
+// FORMAT=JSON/JSON-PRETTY. EPOCH stays a string here so it can be parsed
+// with ommEpochLayout before landing in GP.
+type jsonGP struct {
+	ObjectName         string  `json:"OBJECT_NAME"`
+	ObjectID           string  `json:"OBJECT_ID"`
+	Epoch              string  `json:"EPOCH"`
+	MeanMotion         float64 `json:"MEAN_MOTION"`
+	Eccentricity       float64 `json:"ECCENTRICITY"`
+	Inclination        float64 `json:"INCLINATION"`
+	RAOfAscNode        float64 `json:"RA_OF_ASC_NODE"`
+	ArgOfPericenter    float64 `json:"ARG_OF_PERICENTER"`
+	MeanAnomaly        float64 `json:"MEAN_ANOMALY"`
+	BStar              float64 `json:"BSTAR"`
+	MeanMotionDot      float64 `json:"MEAN_MOTION_DOT"`
+	MeanMotionDDot     float64 `json:"MEAN_MOTION_DDOT"`
+	NoradCatID         int     `json:"NORAD_CAT_ID"`
+	ClassificationType string  `json:"CLASSIFICATION_TYPE"`
+	ElementSetNo       int     `json:"ELEMENT_SET_NO"`
+	RevAtEpoch         int     `json:"REV_AT_EPOCH"`
+}
+
+// ParseOMMJSON parses OMM records served as FORMAT=JSON or JSON-PRETTY.
+func ParseOMMJSON(data []byte) ([]GP, error) {
+	var records []jsonGP
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, fmt.Errorf("decode OMM JSON: %w", err)
+	}
+
+	var gps []GP
+	var errs []error
+	for i, r := range records {
+		epoch, err := parseOMMEpoch(r.Epoch)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("record %d (%s): epoch: %w", i, r.ObjectID, err))
+			continue
+		}
+		gps = append(gps, GP{
+			OBJECT_NAME:        r.ObjectName,
+			OBJECT_ID:          r.ObjectID,
+			EPOCH:              epoch,
+			MeanMotion:         r.MeanMotion,
+			Eccentricity:       r.Eccentricity,
+			Inclination:        r.Inclination,
+			RAAN:               r.RAOfAscNode,
+			ArgOfPericenter:    r.ArgOfPericenter,
+			MeanAnomaly:        r.MeanAnomaly,
+			BSTAR:              r.BStar,
+			MeanMotionDot:      r.MeanMotionDot,
+			MeanMotionDDot:     r.MeanMotionDDot,
+			NORAD_CAT_ID:       r.NoradCatID,
+			ClassificationType: r.ClassificationType,
+			ElementSetNo:       r.ElementSetNo,
+			RevAtEpoch:         r.RevAtEpoch,
+		})
+	}
+
+	if len(errs) > 0 {
+		return gps, &MultiError{Errs: errs}
+	}
+	return gps, nil
+}
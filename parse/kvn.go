@@ -0,0 +1,140 @@
+package parse
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseOMMKVN parses OMM records served as FORMAT=KVN: line-oriented
+// "KEY = VALUE [UNITS]" pairs, one record per OBJECT_NAME line, with
+// COMMENT lines and blank lines ignored.
+func ParseOMMKVN(data []byte) ([]GP, error) {
+	var gps []GP
+	var errs []error
+	var cur map[string]string
+	recordIdx := -1
+
+	flush := func() {
+		if cur == nil {
+			return
+		}
+		gp, err := kvnRecordToGP(cur)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("record %d: %w", recordIdx, err))
+			return
+		}
+		gps = append(gps, gp)
+	}
+
+	for _, rawLine := range strings.Split(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n") {
+		line := strings.TrimSpace(rawLine)
+		if line == "" || strings.HasPrefix(line, "COMMENT") {
+			continue
+		}
+
+		key, val, ok := splitKVN(line)
+		if !ok {
+			continue
+		}
+
+		if key == "OBJECT_NAME" {
+			flush()
+			cur = map[string]string{}
+			recordIdx++
+		}
+		if cur == nil {
+			continue
+		}
+		cur[key] = val
+	}
+	flush()
+
+	if len(errs) > 0 {
+		return gps, &MultiError{Errs: errs}
+	}
+	return gps, nil
+}
+
+// splitKVN splits a "KEY = VALUE [UNITS]" line, dropping a bracketed units
+// suffix from the value.
+func splitKVN(line string) (key, val string, ok bool) {
+	i := strings.Index(line, "=")
+	if i < 0 {
+		return "", "", false
+	}
+	key = strings.TrimSpace(line[:i])
+	val = strings.TrimSpace(line[i+1:])
+	if j := strings.Index(val, "["); j >= 0 {
+		val = strings.TrimSpace(val[:j])
+	}
+	return key, val, true
+}
+
+func kvnRecordToGP(rec map[string]string) (GP, error) {
+	epoch, err := parseOMMEpoch(rec["EPOCH"])
+	if err != nil {
+		return GP{}, fmt.Errorf("epoch: %w", err)
+	}
+
+	floatField := func(key string) (float64, error) {
+		v, ok := rec[key]
+		if !ok {
+			return 0, nil
+		}
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", key, err)
+		}
+		return f, nil
+	}
+	intField := func(key string) (int, error) {
+		v, ok := rec[key]
+		if !ok {
+			return 0, nil
+		}
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", key, err)
+		}
+		return n, nil
+	}
+
+	var gp GP
+	gp.OBJECT_NAME = rec["OBJECT_NAME"]
+	gp.OBJECT_ID = rec["OBJECT_ID"]
+	gp.EPOCH = epoch
+	gp.ClassificationType = rec["CLASSIFICATION_TYPE"]
+
+	for field, dst := range map[string]*float64{
+		"MEAN_MOTION":       &gp.MeanMotion,
+		"ECCENTRICITY":      &gp.Eccentricity,
+		"INCLINATION":       &gp.Inclination,
+		"RA_OF_ASC_NODE":    &gp.RAAN,
+		"ARG_OF_PERICENTER": &gp.ArgOfPericenter,
+		"MEAN_ANOMALY":      &gp.MeanAnomaly,
+		"BSTAR":             &gp.BSTAR,
+		"MEAN_MOTION_DOT":   &gp.MeanMotionDot,
+		"MEAN_MOTION_DDOT":  &gp.MeanMotionDDot,
+	} {
+		v, err := floatField(field)
+		if err != nil {
+			return GP{}, err
+		}
+		*dst = v
+	}
+
+	for field, dst := range map[string]*int{
+		"NORAD_CAT_ID":   &gp.NORAD_CAT_ID,
+		"ELEMENT_SET_NO": &gp.ElementSetNo,
+		"REV_AT_EPOCH":   &gp.RevAtEpoch,
+	} {
+		n, err := intField(field)
+		if err != nil {
+			return GP{}, err
+		}
+		*dst = n
+	}
+
+	return gp, nil
+}
@@ -0,0 +1,282 @@
+package parse
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseTLE parses 3-line (name + two TLE lines) element sets, the layout
+// Celestrak serves for FORMAT=TLE and FORMAT=3LE. Records that fail to
+// parse are skipped; if any do, the returned GPs are still usable and the
+// error is a *MultiError describing what was dropped.
+func ParseTLE(data []byte) ([]GP, error) {
+	return parseTLEText(data, true)
+}
+
+// Parse2LE parses 2-line element sets with no name line, the layout
+// Celestrak serves for FORMAT=2LE.
+func Parse2LE(data []byte) ([]GP, error) {
+	return parseTLEText(data, false)
+}
+
+func parseTLEText(data []byte, hasName bool) ([]GP, error) {
+	lines := splitNonEmptyLines(string(data))
+	stride := 2
+	if hasName {
+		stride = 3
+	}
+
+	var gps []GP
+	var errs []error
+	for i := 0; i+stride <= len(lines); i += stride {
+		var name string
+		line1, line2 := lines[i], lines[i+1]
+		if hasName {
+			name = strings.TrimSpace(strings.TrimPrefix(lines[i], "0 "))
+			line1, line2 = lines[i+1], lines[i+2]
+		}
+
+		gp, err := parseTLERecord(name, line1, line2)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("record %d: %w", i/stride, err))
+			continue
+		}
+		gps = append(gps, gp)
+	}
+
+	if len(errs) > 0 {
+		return gps, &MultiError{Errs: errs}
+	}
+	return gps, nil
+}
+
+func splitNonEmptyLines(s string) []string {
+	raw := strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+	lines := make([]string, 0, len(raw))
+	for _, l := range raw {
+		if strings.TrimSpace(l) != "" {
+			lines = append(lines, l)
+		}
+	}
+	return lines
+}
+
+// parseTLERecord parses a single two-line element set using the standard
+// NORAD fixed-column layout. Column offsets below are 0-indexed half-open
+// ranges over the 1-indexed column numbers from the TLE spec.
+func parseTLERecord(name, line1, line2 string) (GP, error) {
+	if len(line1) < 69 || !strings.HasPrefix(line1, "1 ") {
+		return GP{}, fmt.Errorf("malformed line 1: %q", line1)
+	}
+	if len(line2) < 69 || !strings.HasPrefix(line2, "2 ") {
+		return GP{}, fmt.Errorf("malformed line 2: %q", line2)
+	}
+	if !checksumOK(line1) {
+		return GP{}, fmt.Errorf("line 1 checksum mismatch")
+	}
+	if !checksumOK(line2) {
+		return GP{}, fmt.Errorf("line 2 checksum mismatch")
+	}
+
+	noradID, err := strconv.Atoi(strings.TrimSpace(line1[2:7]))
+	if err != nil {
+		return GP{}, fmt.Errorf("satellite number: %w", err)
+	}
+
+	epoch, err := parseTLEEpoch(line1[18:32])
+	if err != nil {
+		return GP{}, fmt.Errorf("epoch: %w", err)
+	}
+
+	objectID := intlDesignatorToObjectID(line1[9:17])
+
+	meanMotionDot, err := strconv.ParseFloat(strings.TrimSpace(line1[33:43]), 64)
+	if err != nil {
+		return GP{}, fmt.Errorf("mean motion dot: %w", err)
+	}
+
+	meanMotionDDot, err := parseImpliedDecimal(line1[44:52])
+	if err != nil {
+		return GP{}, fmt.Errorf("mean motion ddot: %w", err)
+	}
+
+	bstar, err := parseImpliedDecimal(line1[53:61])
+	if err != nil {
+		return GP{}, fmt.Errorf("bstar: %w", err)
+	}
+
+	elementSetNo, err := strconv.Atoi(strings.TrimSpace(line1[64:68]))
+	if err != nil {
+		return GP{}, fmt.Errorf("element set number: %w", err)
+	}
+
+	inclination, err := strconv.ParseFloat(strings.TrimSpace(line2[8:16]), 64)
+	if err != nil {
+		return GP{}, fmt.Errorf("inclination: %w", err)
+	}
+	raan, err := strconv.ParseFloat(strings.TrimSpace(line2[17:25]), 64)
+	if err != nil {
+		return GP{}, fmt.Errorf("RAAN: %w", err)
+	}
+	eccentricity, err := strconv.ParseFloat("0."+strings.TrimSpace(line2[26:33]), 64)
+	if err != nil {
+		return GP{}, fmt.Errorf("eccentricity: %w", err)
+	}
+	argOfPericenter, err := strconv.ParseFloat(strings.TrimSpace(line2[34:42]), 64)
+	if err != nil {
+		return GP{}, fmt.Errorf("argument of pericenter: %w", err)
+	}
+	meanAnomaly, err := strconv.ParseFloat(strings.TrimSpace(line2[43:51]), 64)
+	if err != nil {
+		return GP{}, fmt.Errorf("mean anomaly: %w", err)
+	}
+	meanMotion, err := strconv.ParseFloat(strings.TrimSpace(line2[52:63]), 64)
+	if err != nil {
+		return GP{}, fmt.Errorf("mean motion: %w", err)
+	}
+	revAtEpoch, err := strconv.Atoi(strings.TrimSpace(line2[63:68]))
+	if err != nil {
+		return GP{}, fmt.Errorf("rev at epoch: %w", err)
+	}
+
+	return GP{
+		OBJECT_NAME:        name,
+		OBJECT_ID:          objectID,
+		EPOCH:              epoch,
+		MeanMotion:         meanMotion,
+		Eccentricity:       eccentricity,
+		Inclination:        inclination,
+		RAAN:               raan,
+		ArgOfPericenter:    argOfPericenter,
+		MeanAnomaly:        meanAnomaly,
+		BSTAR:              bstar,
+		MeanMotionDot:      meanMotionDot,
+		MeanMotionDDot:     meanMotionDDot,
+		NORAD_CAT_ID:       noradID,
+		ClassificationType: strings.TrimSpace(line1[7:8]),
+		ElementSetNo:       elementSetNo,
+		RevAtEpoch:         revAtEpoch,
+	}, nil
+}
+
+// checksumOK verifies the modulo-10 checksum in a TLE line's final column:
+// every digit adds its value, every '-' adds 1, everything else adds 0.
+func checksumOK(line string) bool {
+	want, err := strconv.Atoi(string(line[68]))
+	if err != nil {
+		return false
+	}
+	sum := 0
+	for _, r := range line[:68] {
+		switch {
+		case r >= '0' && r <= '9':
+			sum += int(r - '0')
+		case r == '-':
+			sum++
+		}
+	}
+	return sum%10 == want
+}
+
+// intlDesignatorToObjectID converts a TLE international designator field
+// (columns 10-17: 2-digit launch year, 3-digit launch number, up to a
+// 3-letter piece, e.g. "98067A  ") into the "YYYY-NNNP" form ParseOMMJSON
+// /XML/KVN/CSV populate OBJECT_ID with, e.g. "1998-067A". Uses the same
+// 57-99 -> 19xx, 00-56 -> 20xx pivot as the epoch field. Returns "" if
+// the field is blank or doesn't start with a 2-digit year.
+func intlDesignatorToObjectID(field string) string {
+	field = strings.TrimRight(field, " ")
+	if len(field) < 2 {
+		return ""
+	}
+	yy, err := strconv.Atoi(field[:2])
+	if err != nil {
+		return ""
+	}
+	rest := field[2:]
+	if rest == "" {
+		return ""
+	}
+	year := 1900 + yy
+	if yy < 57 {
+		year = 2000 + yy
+	}
+	return fmt.Sprintf("%04d-%s", year, rest)
+}
+
+// parseTLEEpoch decodes the TLE epoch field: a 2-digit year (57-99 ->
+// 19xx, 00-56 -> 20xx) followed by the fractional day of the year.
+func parseTLEEpoch(field string) (time.Time, error) {
+	field = strings.TrimSpace(field)
+	if len(field) < 3 {
+		return time.Time{}, fmt.Errorf("invalid epoch %q", field)
+	}
+
+	yy, err := strconv.Atoi(field[:2])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("epoch year: %w", err)
+	}
+	year := 1900 + yy
+	if yy < 57 {
+		year = 2000 + yy
+	}
+
+	dayOfYear, err := strconv.ParseFloat(field[2:], 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("epoch day: %w", err)
+	}
+	if dayOfYear < 1 {
+		return time.Time{}, fmt.Errorf("epoch day out of range: %v", dayOfYear)
+	}
+
+	wholeDay := int(dayOfYear)
+	frac := dayOfYear - float64(wholeDay)
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, wholeDay-1)
+	return start.Add(time.Duration(frac * float64(24*time.Hour))), nil
+}
+
+// parseImpliedDecimal parses the compressed "assumed decimal point"
+// exponential notation used for BSTAR and the second mean motion
+// derivative, e.g. "-11606-4" -> -0.11606e-4, " 00000-0" -> 0.
+func parseImpliedDecimal(field string) (float64, error) {
+	s := strings.TrimSpace(field)
+	if s == "" {
+		return 0, nil
+	}
+
+	sign := 1.0
+	switch s[0] {
+	case '-':
+		sign = -1
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+	if len(s) < 2 {
+		return 0, fmt.Errorf("invalid implied-decimal value %q", field)
+	}
+
+	mantissaDigits, expPart := s[:len(s)-2], s[len(s)-2:]
+	if mantissaDigits == "" {
+		return 0, nil
+	}
+
+	expSign := 1
+	if expPart[0] == '-' {
+		expSign = -1
+	}
+	exp, err := strconv.Atoi(string(expPart[1]))
+	if err != nil {
+		return 0, fmt.Errorf("invalid exponent in %q: %w", field, err)
+	}
+
+	mantissa, err := strconv.ParseFloat("0."+mantissaDigits, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid mantissa in %q: %w", field, err)
+	}
+
+	return sign * mantissa * math.Pow10(expSign*exp), nil
+}
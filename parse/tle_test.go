@@ -0,0 +1,144 @@
+package parse
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// issLine1/issLine2 is the canonical ISS (ZARYA) TLE used throughout the
+// SGP4 literature, chosen here because its checksum digits, epoch, and
+// implied-decimal fields are independently well known.
+const (
+	issName  = "ISS (ZARYA)"
+	issLine1 = "1 25544U 98067A   08264.51782528 -.00002182  00000-0 -11606-4 0  2927"
+	issLine2 = "2 25544  51.6416 247.4627 0006703 130.5360 325.0288 15.72125391563537"
+)
+
+func TestParseTLE(t *testing.T) {
+	data := "0 " + issName + "\n" + issLine1 + "\n" + issLine2 + "\n"
+	gps, err := ParseTLE([]byte(data))
+	if err != nil {
+		t.Fatalf("ParseTLE: %v", err)
+	}
+	if len(gps) != 1 {
+		t.Fatalf("got %d records, want 1", len(gps))
+	}
+
+	gp := gps[0]
+	if gp.OBJECT_NAME != issName {
+		t.Errorf("OBJECT_NAME = %q, want %q", gp.OBJECT_NAME, issName)
+	}
+	if gp.NORAD_CAT_ID != 25544 {
+		t.Errorf("NORAD_CAT_ID = %d, want 25544", gp.NORAD_CAT_ID)
+	}
+	wantEpoch := time.Date(2008, time.September, 20, 12, 25, 40, 0, time.UTC)
+	if diff := gp.EPOCH.Sub(wantEpoch); diff < -time.Second || diff > time.Second {
+		t.Errorf("EPOCH = %v, want ~%v", gp.EPOCH, wantEpoch)
+	}
+	if gp.BSTAR != -0.11606e-4 {
+		t.Errorf("BSTAR = %v, want -0.11606e-4", gp.BSTAR)
+	}
+	if gp.OBJECT_ID != "1998-067A" {
+		t.Errorf("OBJECT_ID = %q, want %q", gp.OBJECT_ID, "1998-067A")
+	}
+}
+
+func TestIntlDesignatorToObjectID(t *testing.T) {
+	tests := []struct {
+		name  string
+		field string
+		want  string
+	}{
+		{"iss, 1990s pivot", "98067A  ", "1998-067A"},
+		{"2000s pivot", "24079B  ", "2024-079B"},
+		{"pivot boundary 56 -> 20xx", "56001A  ", "2056-001A"},
+		{"pivot boundary 57 -> 19xx", "57001A  ", "1957-001A"},
+		{"no piece letter", "98067   ", "1998-067"},
+		{"blank field", "        ", ""},
+		{"too short", "9", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := intlDesignatorToObjectID(tt.field)
+			if got != tt.want {
+				t.Errorf("intlDesignatorToObjectID(%q) = %q, want %q", tt.field, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse2LE(t *testing.T) {
+	data := issLine1 + "\n" + issLine2 + "\n"
+	gps, err := Parse2LE([]byte(data))
+	if err != nil {
+		t.Fatalf("Parse2LE: %v", err)
+	}
+	if len(gps) != 1 {
+		t.Fatalf("got %d records, want 1", len(gps))
+	}
+	if gps[0].OBJECT_NAME != "" {
+		t.Errorf("OBJECT_NAME = %q, want empty (no name line in 2LE)", gps[0].OBJECT_NAME)
+	}
+}
+
+func TestParseTLEChecksumMismatch(t *testing.T) {
+	bad := "1 25544U 98067A   08264.51782528 -.00002182  00000-0 -11606-4 0  2928\n" + issLine2 + "\n"
+	gps, err := Parse2LE([]byte(bad))
+	if err == nil {
+		t.Fatalf("expected a checksum error, got none")
+	}
+	if len(gps) != 0 {
+		t.Errorf("got %d records for a bad record, want 0", len(gps))
+	}
+	if _, ok := err.(*MultiError); !ok {
+		t.Fatalf("error %v is not a *MultiError", err)
+	}
+}
+
+func TestParseTLEEpochYearPivot(t *testing.T) {
+	tests := []struct {
+		name  string
+		field string
+		want  int
+	}{
+		{"two-digit year 57 is 1957", "57001.00000000", 1957},
+		{"two-digit year 99 is 1999", "99365.00000000", 1999},
+		{"two-digit year 00 is 2000", "00001.00000000", 2000},
+		{"two-digit year 56 is 2056", "56200.50000000", 2056},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseTLEEpoch(tt.field)
+			if err != nil {
+				t.Fatalf("parseTLEEpoch(%q): %v", tt.field, err)
+			}
+			if got.Year() != tt.want {
+				t.Errorf("parseTLEEpoch(%q).Year() = %d, want %d", tt.field, got.Year(), tt.want)
+			}
+		})
+	}
+}
+
+func TestParseImpliedDecimal(t *testing.T) {
+	tests := []struct {
+		field string
+		want  float64
+	}{
+		{"-11606-4", -0.11606e-4},
+		{" 00000-0", 0},
+		{"+12345-3", 0.12345e-3},
+		{"28098-4", 0.28098e-4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			got, err := parseImpliedDecimal(tt.field)
+			if err != nil {
+				t.Fatalf("parseImpliedDecimal(%q): %v", tt.field, err)
+			}
+			if math.Abs(got-tt.want) > 1e-12 {
+				t.Errorf("parseImpliedDecimal(%q) = %v, want %v", tt.field, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package parse
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+)
+
+// ommXML mirrors a single CCSDS OMM <omm> element as Celestrak emits it:
+// a metadata section plus a data section split into meanElements and
+// tleParameters.
+type ommXML struct {
+	Metadata struct {
+		ObjectName string `xml:"OBJECT_NAME"`
+		ObjectID   string `xml:"OBJECT_ID"`
+	} `xml:"metadata"`
+	Data struct {
+		MeanElements struct {
+			Epoch           string  `xml:"EPOCH"`
+			MeanMotion      float64 `xml:"MEAN_MOTION"`
+			Eccentricity    float64 `xml:"ECCENTRICITY"`
+			Inclination     float64 `xml:"INCLINATION"`
+			RAOfAscNode     float64 `xml:"RA_OF_ASC_NODE"`
+			ArgOfPericenter float64 `xml:"ARG_OF_PERICENTER"`
+			MeanAnomaly     float64 `xml:"MEAN_ANOMALY"`
+		} `xml:"meanElements"`
+		TLEParameters struct {
+			ClassificationType string  `xml:"CLASSIFICATION_TYPE"`
+			NoradCatID         int     `xml:"NORAD_CAT_ID"`
+			ElementSetNo       int     `xml:"ELEMENT_SET_NO"`
+			RevAtEpoch         int     `xml:"REV_AT_EPOCH"`
+			BStar              float64 `xml:"BSTAR"`
+			MeanMotionDot      float64 `xml:"MEAN_MOTION_DOT"`
+			MeanMotionDDot     float64 `xml:"MEAN_MOTION_DDOT"`
+		} `xml:"tleParameters"`
+	} `xml:"data"`
+}
+
+// ParseOMMXML parses OMM records served as FORMAT=XML. Celestrak wraps one
+// <omm> element per object inside a collection whose outer element varies
+// by endpoint, so rather than model that wrapper exactly, this scans the
+// token stream for <omm> elements wherever they occur.
+func ParseOMMXML(data []byte) ([]GP, error) {
+	dec := xml.NewDecoder(bytes.NewReader(data))
+
+	var gps []GP
+	var errs []error
+	i := 0
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return gps, fmt.Errorf("decode OMM XML: %w", err)
+		}
+
+		start, ok := tok.(xml.StartElement)
+		if !ok || start.Name.Local != "omm" {
+			continue
+		}
+
+		var rec ommXML
+		if err := dec.DecodeElement(&rec, &start); err != nil {
+			errs = append(errs, fmt.Errorf("record %d: %w", i, err))
+			i++
+			continue
+		}
+
+		epoch, err := parseOMMEpoch(rec.Data.MeanElements.Epoch)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("record %d (%s): epoch: %w", i, rec.Metadata.ObjectID, err))
+			i++
+			continue
+		}
+
+		gps = append(gps, GP{
+			OBJECT_NAME:        rec.Metadata.ObjectName,
+			OBJECT_ID:          rec.Metadata.ObjectID,
+			EPOCH:              epoch,
+			MeanMotion:         rec.Data.MeanElements.MeanMotion,
+			Eccentricity:       rec.Data.MeanElements.Eccentricity,
+			Inclination:        rec.Data.MeanElements.Inclination,
+			RAAN:               rec.Data.MeanElements.RAOfAscNode,
+			ArgOfPericenter:    rec.Data.MeanElements.ArgOfPericenter,
+			MeanAnomaly:        rec.Data.MeanElements.MeanAnomaly,
+			BSTAR:              rec.Data.TLEParameters.BStar,
+			MeanMotionDot:      rec.Data.TLEParameters.MeanMotionDot,
+			MeanMotionDDot:     rec.Data.TLEParameters.MeanMotionDDot,
+			NORAD_CAT_ID:       rec.Data.TLEParameters.NoradCatID,
+			ClassificationType: rec.Data.TLEParameters.ClassificationType,
+			ElementSetNo:       rec.Data.TLEParameters.ElementSetNo,
+			RevAtEpoch:         rec.Data.TLEParameters.RevAtEpoch,
+		})
+		i++
+	}
+
+	if len(errs) > 0 {
+		return gps, &MultiError{Errs: errs}
+	}
+	return gps, nil
+}
@@ -0,0 +1,59 @@
+package propagate
+
+import "math"
+
+// gravity bundles the Earth constants SGP4 is parameterized over. Values
+// are the classical WGS72/WGS84 sets from Spacetrack Report #3 and
+// Vallado's revisiting of it, expressed in the canonical units SGP4 uses
+// internally: Earth radii for distance, minutes for time.
+type gravity struct {
+	mu     float64 // km^3/s^2, gravitational parameter
+	xkmper float64 // km, Earth equatorial radius
+	xke    float64 // sqrt(GM) in earth-radii^1.5 / min
+	ck2    float64
+	ck4    float64
+	j3     float64
+	s      float64 // atmospheric density function boundary parameter
+	qoms2t float64
+}
+
+const (
+	ae     = 1.0    // earth radii, distance unit
+	xmnpda = 1440.0 // minutes per day
+	de2ra  = math.Pi / 180.0
+)
+
+func gravityConstants(m GravityModel) gravity {
+	switch m {
+	case WGS84:
+		return newGravity(398600.5, 6378.137, -0.00000253215306)
+	default: // WGS72
+		return newGravity(398600.8, 6378.135, -0.253881e-5)
+	}
+}
+
+// newGravity derives the canonical-unit constants (xke, ck2, ck4, s,
+// qoms2t) from the physical constants, mirroring the SGP4 initialization
+// every published implementation performs once per gravity model.
+func newGravity(mu, xkmper, j3 float64) gravity {
+	xke := 60.0 / math.Sqrt(xkmper*xkmper*xkmper/mu)
+	// J2 and J4 are effectively fixed across WGS72/WGS84 for SGP4's
+	// purposes; only mu, Re, and J3 vary meaningfully between the models.
+	j2 := 0.001082616
+	j4 := -0.00000165597
+	ck2 := j2 / 2.0
+	ck4 := -0.375 * j4
+	s := 1.01222928 // (1 + 78/xkmper) in earth radii, classic SGP4 value
+	qoms24 := math.Pow((120-78)/xkmper, 4)
+
+	return gravity{
+		mu:     mu,
+		xkmper: xkmper,
+		xke:    xke,
+		ck2:    ck2,
+		ck4:    ck4,
+		j3:     j3,
+		s:      s,
+		qoms2t: qoms24,
+	}
+}
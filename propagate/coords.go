@@ -0,0 +1,104 @@
+package propagate
+
+import (
+	"math"
+	"time"
+)
+
+// wgs84A and wgs84F are used for the geodetic conversion regardless of
+// which GravityModel the propagator was initialized with: they describe
+// the reference ellipsoid for latitude/longitude/altitude, not the
+// gravity field used during propagation.
+const (
+	wgs84A = 6378.137          // km, semimajor axis
+	wgs84F = 1 / 298.257223563 // flattening
+)
+
+// gmst returns the Greenwich Mean Sidereal Time, in radians, for t. Used
+// to rotate TEME vectors into ECEF.
+func gmst(t time.Time) float64 {
+	t = t.UTC()
+	jd := julianDate(t)
+	tUT1 := (jd - 2451545.0) / 36525.0
+
+	// IAU 1982 GMST polynomial, in seconds.
+	thetaSec := 67310.54841 +
+		(876600*3600+8640184.812866)*tUT1 +
+		0.093104*tUT1*tUT1 -
+		6.2e-6*tUT1*tUT1*tUT1
+
+	theta := math.Mod(thetaSec*(math.Pi/180.0)/240.0, 2*math.Pi)
+	if theta < 0 {
+		theta += 2 * math.Pi
+	}
+	return theta
+}
+
+func julianDate(t time.Time) float64 {
+	y, mo, d := t.Date()
+	h, mi, s := t.Clock()
+	ns := t.Nanosecond()
+
+	if mo <= 2 {
+		y--
+		mo += 12
+	}
+	a := y / 100
+	b := 2 - a + a/4
+
+	dayFrac := (float64(h) + float64(mi)/60 + (float64(s)+float64(ns)/1e9)/3600) / 24
+	jd := math.Floor(365.25*float64(y+4716)) + math.Floor(30.6001*float64(mo+1)) +
+		float64(d) + dayFrac + float64(b) - 1524.5
+	return jd
+}
+
+// TEMEToECEF rotates a TEME state vector into Earth-Centered,
+// Earth-Fixed coordinates at time t by applying the Earth rotation angle
+// (GMST); it does not correct for polar motion or precession-nutation,
+// which are below SGP4's own accuracy.
+func TEMEToECEF(s State, t time.Time) State {
+	theta := gmst(t)
+	sinT, cosT := math.Sin(theta), math.Cos(theta)
+
+	rotate := func(x, y float64) (float64, float64) {
+		return cosT*x + sinT*y, -sinT*x + cosT*y
+	}
+
+	px, py := rotate(s.Position[0], s.Position[1])
+	vx, vy := rotate(s.Velocity[0], s.Velocity[1])
+
+	// Earth's rotation also contributes to the ECEF-frame velocity via
+	// omega x r, since ECEF rotates with the Earth while TEME does not.
+	const earthRotRateRadPerSec = 7.292115146706979e-5
+	vx += earthRotRateRadPerSec * py
+	vy -= earthRotRateRadPerSec * px
+
+	return State{
+		Position: [3]float64{px, py, s.Position[2]},
+		Velocity: [3]float64{vx, vy, s.Velocity[2]},
+	}
+}
+
+// ECEFToGeodetic converts an ECEF position (ignoring velocity) to
+// geodetic latitude/longitude (degrees) and altitude (km) above the WGS84
+// ellipsoid, using Bowring's iterative method.
+func ECEFToGeodetic(s State) (latDeg, lonDeg, altKm float64) {
+	x, y, z := s.Position[0], s.Position[1], s.Position[2]
+
+	e2 := wgs84F * (2 - wgs84F)
+	p := math.Hypot(x, y)
+	lon := math.Atan2(y, x)
+
+	lat := math.Atan2(z, p*(1-e2))
+	for i := 0; i < 5; i++ {
+		sinLat := math.Sin(lat)
+		n := wgs84A / math.Sqrt(1-e2*sinLat*sinLat)
+		lat = math.Atan2(z+e2*n*sinLat, p)
+	}
+
+	sinLat := math.Sin(lat)
+	n := wgs84A / math.Sqrt(1-e2*sinLat*sinLat)
+	alt := p/math.Cos(lat) - n
+
+	return lat * 180 / math.Pi, lon * 180 / math.Pi, alt
+}
@@ -0,0 +1,168 @@
+package propagate
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/avyayk/celestrak-go/parse"
+)
+
+// elements holds everything SGP4/SDP4 need at propagation time: the
+// recovered mean motion/semimajor axis, the original mean elements in
+// radians, and the secular coefficients (C1..C5, the various *dot rates)
+// derived once at initialization.
+type elements struct {
+	g gravity
+
+	// Mean elements at epoch, in radians / radians-per-minute.
+	bstar float64
+	inclo float64
+	nodeo float64
+	ecco  float64
+	argpo float64
+	mo    float64
+	no    float64 // recovered mean motion, rad/min
+	ao    float64 // recovered semimajor axis, earth radii
+
+	// Derived secular terms.
+	cosio, sinio           float64
+	x3thm1, x1mth2, x7thm1 float64
+	c1, c2, c3, c4, c5     float64
+	t2cof                  float64
+	xmdot, omgdot, xnodot  float64
+	xnodcf                 float64
+	xlcof, aycof           float64
+	delmo, sinmo           float64
+	omgcof, xmcof          float64
+
+	isimp               bool // true when perigee < 220 km: skips the higher-order d2-d4/t3-t5 terms
+	d2, d3, d4          float64
+	t3cof, t4cof, t5cof float64
+}
+
+// initElements performs the classic SGP4 initialization from Spacetrack
+// Report #3: recovering the original mean motion (no) and semimajor axis
+// (ao) from the Kozai mean motion via the a1->delta1->a0->delta0 cycle,
+// then computing the q0-s4 drag constants and the C1..C5 secular
+// coefficients used by the update loop.
+func initElements(gp parse.GP, g gravity) (elements, error) {
+	if gp.Eccentricity < 0 || gp.Eccentricity >= 1 {
+		return elements{}, fmt.Errorf("eccentricity out of range: %v", gp.Eccentricity)
+	}
+	if gp.MeanMotion <= 0 {
+		return elements{}, fmt.Errorf("mean motion must be positive: %v", gp.MeanMotion)
+	}
+
+	el := elements{
+		g:     g,
+		bstar: gp.BSTAR,
+		inclo: gp.Inclination * de2ra,
+		nodeo: gp.RAAN * de2ra,
+		ecco:  gp.Eccentricity,
+		argpo: gp.ArgOfPericenter * de2ra,
+		mo:    gp.MeanAnomaly * de2ra,
+	}
+
+	n0 := gp.MeanMotion * 2 * math.Pi / xmnpda // rad/min
+
+	cosio := math.Cos(el.inclo)
+	sinio := math.Sin(el.inclo)
+	theta2 := cosio * cosio
+	x3thm1 := 3*theta2 - 1
+	eosq := el.ecco * el.ecco
+	betao2 := 1 - eosq
+	betao := math.Sqrt(betao2)
+
+	a1 := math.Pow(g.xke/n0, 2.0/3.0)
+	del1 := 1.5 * g.ck2 * x3thm1 / (a1 * a1 * betao * betao2)
+	ao := a1 * (1 - del1*(1.0/3.0+del1*(1+134.0/81.0*del1)))
+	delo := 1.5 * g.ck2 * x3thm1 / (ao * ao * betao * betao2)
+	no := n0 / (1 + delo)
+	aodp := ao / (1 - delo)
+
+	el.no = no
+	el.ao = aodp
+	el.cosio, el.sinio = cosio, sinio
+	el.x3thm1 = x3thm1
+	el.x1mth2 = 1 - theta2
+	el.x7thm1 = 7*theta2 - 1
+
+	perige := (aodp*(1-el.ecco) - ae) * g.xkmper
+	el.isimp = perige < 220
+
+	s4 := g.s
+	qoms24 := g.qoms2t
+	if perige < 156 {
+		sLow := perige - 78
+		if perige < 98 {
+			sLow = 20
+		}
+		s4 = sLow/g.xkmper + ae
+		qoms24 = math.Pow((120-sLow)/g.xkmper, 4)
+	}
+
+	pinvsq := 1 / (aodp * aodp * betao2 * betao2)
+	tsi := 1 / (aodp - s4)
+	eta := aodp * el.ecco * tsi
+	etasq := eta * eta
+	eeta := el.ecco * eta
+	psisq := math.Abs(1 - etasq)
+	coef := qoms24 * math.Pow(tsi, 4)
+	coef1 := coef / math.Pow(psisq, 3.5)
+
+	c2 := coef1 * no * (aodp*(1+1.5*etasq+eeta*(4+etasq)) +
+		0.75*g.ck2*tsi/psisq*x3thm1*(8+3*etasq*(8+etasq)))
+	c1 := el.bstar * c2
+	el.c1, el.c2 = c1, c2
+
+	a3ovk2 := -g.j3 / g.ck2 * ae * ae * ae
+	c3 := 0.0
+	xmcof := 0.0
+	if el.ecco > 1e-4 {
+		c3 = coef * tsi * a3ovk2 * no * ae * sinio / el.ecco
+		xmcof = -2.0 / 3.0 * coef * el.bstar * ae / eeta
+	}
+	el.c3 = c3
+	el.xmcof = xmcof
+	el.omgcof = el.bstar * c3 * math.Cos(el.argpo)
+
+	cosArgp2 := math.Cos(2 * el.argpo)
+	c4 := 2 * no * coef1 * aodp * betao2 * (eta*(2+0.5*etasq) + el.ecco*(0.5+2*etasq) -
+		2*g.ck2*tsi/(aodp*psisq)*(-3*x3thm1*(1-2*eeta+etasq*(1.5-0.5*eeta))+
+			0.75*el.x1mth2*(2*etasq-eeta*(1+etasq))*cosArgp2))
+	el.c4 = c4
+	el.c5 = 2 * coef1 * aodp * betao2 * (1 + 2.75*(etasq+eeta) + eeta*etasq)
+
+	theta4 := theta2 * theta2
+	temp1 := 3 * g.ck2 * pinvsq * no
+	temp2 := temp1 * g.ck2 * pinvsq
+	temp3 := 1.25 * g.ck4 * pinvsq * pinvsq * no
+
+	el.xmdot = no + 0.5*temp1*betao*x3thm1 + 0.0625*temp2*betao*(13-78*theta2+137*theta4)
+	x1m5th := 1 - 5*theta2
+	el.omgdot = -0.5*temp1*x1m5th + 0.0625*temp2*(7-114*theta2+395*theta4) + temp3*(3-36*theta2+49*theta4)
+	xhdot1 := -temp1 * cosio
+	el.xnodot = xhdot1 + (0.5*temp2*(4-19*theta2)+2*temp3*(3-7*theta2))*cosio
+	el.xnodcf = 3.5 * betao2 * xhdot1 * c1
+	el.t2cof = 1.5 * c1
+	if math.Abs(cosio+1) > 1.5e-12 {
+		el.xlcof = 0.125 * a3ovk2 * sinio * (3 + 5*cosio) / (1 + cosio)
+	}
+	el.aycof = 0.25 * a3ovk2 * sinio
+	el.delmo = math.Pow(1+eta*math.Cos(el.mo), 3)
+	el.sinmo = math.Sin(el.mo)
+
+	if !el.isimp {
+		c1sq := c1 * c1
+		d2 := 4 * aodp * tsi * c1sq
+		temp := d2 * tsi * c1 / 3
+		d3 := (17*aodp + s4) * temp
+		d4 := 0.5 * temp * aodp * tsi * (221*aodp + 31*s4) * c1
+		el.d2, el.d3, el.d4 = d2, d3, d4
+		el.t3cof = d2 + 2*c1sq
+		el.t4cof = 0.25 * (3*d3 + c1*(12*d2+10*c1sq))
+		el.t5cof = 0.2 * (3*d4 + 12*c1*d3 + 6*d2*d2 + 15*c1sq*(2*d2+c1sq))
+	}
+
+	return el, nil
+}
@@ -0,0 +1,196 @@
+package propagate
+
+import (
+	"math"
+	"time"
+)
+
+// LatLonAlt is an observer location on (approximately) the WGS84
+// ellipsoid: latitude/longitude in degrees, altitude in km above the
+// ellipsoid.
+type LatLonAlt struct {
+	LatDeg, LonDeg, AltKm float64
+}
+
+// Pass describes one overhead pass: acquisition of signal (AOS), time of
+// closest approach (TCA), and loss of signal (LOS), with the observer's
+// azimuth at AOS and LOS, and elevation, azimuth and range at TCA.
+type Pass struct {
+	AOS, TCA, LOS                time.Time
+	AOSAzimuthDeg, LOSAzimuthDeg float64
+	MaxElevationDeg              float64
+	MaxElevationAzimuthDeg       float64
+	MaxElevationRangeKm          float64
+}
+
+// Passes scans [from, to) at a coarse step looking for elevation sign
+// changes, then bisects around each crossing to locate AOS/LOS, and
+// samples a shrinking window around the elevation peak to locate TCA.
+// Passes below minElevationDeg
+// are omitted. A satellite already above minElevationDeg at from, or
+// still above it at to, has from/to used as its AOS/LOS - the pass is
+// reported truncated to the scan window rather than dropped.
+func Passes(p *Propagator, observer LatLonAlt, from, to time.Time, minElevationDeg float64) []Pass {
+	const coarseStep = 30 * time.Second
+
+	elevAt := func(t time.Time) (azDeg, elDeg, rangeKm float64, ok bool) {
+		s, err := p.At(t)
+		if err != nil {
+			return 0, 0, 0, false
+		}
+		ecef := TEMEToECEF(s, t)
+		az, el, rng := lookAngles(observer, ecef.Position, t)
+		return az, el, rng, true
+	}
+
+	var passes []Pass
+	var aos *time.Time
+	var prevEl float64
+	prevOK := false
+
+	if _, el, _, ok := elevAt(from); ok && el >= minElevationDeg {
+		aosT := from
+		aos = &aosT
+	}
+
+	finalizePass := func(aosT, losT time.Time) {
+		tca, maxEl, maxAz, maxRange := findPeak(elevAt, aosT, losT)
+		if maxEl < minElevationDeg {
+			return
+		}
+		aosAz, _, _, _ := elevAt(aosT)
+		losAz, _, _, _ := elevAt(losT)
+		passes = append(passes, Pass{
+			AOS:                    aosT,
+			TCA:                    tca,
+			LOS:                    losT,
+			AOSAzimuthDeg:          aosAz,
+			LOSAzimuthDeg:          losAz,
+			MaxElevationDeg:        maxEl,
+			MaxElevationAzimuthDeg: maxAz,
+			MaxElevationRangeKm:    maxRange,
+		})
+	}
+
+	for t := from; t.Before(to); t = t.Add(coarseStep) {
+		_, el, _, ok := elevAt(t)
+		if !ok {
+			prevOK = false
+			continue
+		}
+
+		if prevOK {
+			if prevEl < minElevationDeg && el >= minElevationDeg {
+				crossing := bisectCrossing(elevAt, t.Add(-coarseStep), t, minElevationDeg)
+				aos = &crossing
+			} else if aos != nil && prevEl >= minElevationDeg && el < minElevationDeg {
+				crossing := bisectCrossing(elevAt, t.Add(-coarseStep), t, minElevationDeg)
+				finalizePass(*aos, crossing)
+				aos = nil
+			}
+		}
+
+		prevEl = el
+		prevOK = true
+	}
+
+	if aos != nil && prevOK && prevEl >= minElevationDeg {
+		finalizePass(*aos, to)
+	}
+
+	return passes
+}
+
+// bisectCrossing narrows [t0, t1] (known to straddle the elevation
+// threshold) down to a sub-second estimate of the crossing time.
+func bisectCrossing(elevAt func(time.Time) (az, el, rng float64, ok bool), t0, t1 time.Time, threshold float64) time.Time {
+	_, el0, _, _ := elevAt(t0)
+	rising := el0 < threshold
+
+	for i := 0; i < 20; i++ {
+		mid := t0.Add(t1.Sub(t0) / 2)
+		_, el, _, _ := elevAt(mid)
+		if (el >= threshold) == rising {
+			t1 = mid
+		} else {
+			t0 = mid
+		}
+	}
+	return t0.Add(t1.Sub(t0) / 2)
+}
+
+// findPeak performs a ternary-ish refinement by sampling a shrinking
+// window around the elevation maximum between aos and los.
+func findPeak(elevAt func(time.Time) (az, el, rng float64, ok bool), aos, los time.Time) (tca time.Time, maxEl, maxAz, maxRange float64) {
+	lo, hi := aos, los
+	for i := 0; i < 30; i++ {
+		step := hi.Sub(lo) / 10
+		if step <= 0 {
+			break
+		}
+		bestT := lo
+		bestEl := math.Inf(-1)
+		for t := lo; !t.After(hi); t = t.Add(step) {
+			_, el, _, ok := elevAt(t)
+			if ok && el > bestEl {
+				bestEl = el
+				bestT = t
+			}
+		}
+		lo = bestT.Add(-step)
+		if lo.Before(aos) {
+			lo = aos
+		}
+		hi = bestT.Add(step)
+		if hi.After(los) {
+			hi = los
+		}
+		tca = bestT
+		maxEl = bestEl
+	}
+	maxAz, _, maxRange, _ = elevAt(tca)
+	return tca, maxEl, maxAz, maxRange
+}
+
+// lookAngles converts an ECEF satellite position into topocentric
+// azimuth/elevation/range from observer, in degrees and km.
+func lookAngles(observer LatLonAlt, satECEF [3]float64, t time.Time) (azDeg, elDeg, rangeKm float64) {
+	obsECEF := geodeticToECEF(observer)
+
+	dx := satECEF[0] - obsECEF[0]
+	dy := satECEF[1] - obsECEF[1]
+	dz := satECEF[2] - obsECEF[2]
+	rangeKm = math.Sqrt(dx*dx + dy*dy + dz*dz)
+
+	latR := observer.LatDeg * math.Pi / 180
+	lonR := observer.LonDeg * math.Pi / 180
+	sinLat, cosLat := math.Sin(latR), math.Cos(latR)
+	sinLon, cosLon := math.Sin(lonR), math.Cos(lonR)
+
+	// ECEF delta -> South/East/Up (SEZ) topocentric frame.
+	south := sinLat*cosLon*dx + sinLat*sinLon*dy - cosLat*dz
+	east := -sinLon*dx + cosLon*dy
+	up := cosLat*cosLon*dx + cosLat*sinLon*dy + sinLat*dz
+
+	az := math.Atan2(east, -south)
+	if az < 0 {
+		az += 2 * math.Pi
+	}
+	el := math.Asin(up / rangeKm)
+
+	return az * 180 / math.Pi, el * 180 / math.Pi, rangeKm
+}
+
+func geodeticToECEF(loc LatLonAlt) [3]float64 {
+	latR := loc.LatDeg * math.Pi / 180
+	lonR := loc.LonDeg * math.Pi / 180
+	e2 := wgs84F * (2 - wgs84F)
+	sinLat := math.Sin(latR)
+	n := wgs84A / math.Sqrt(1-e2*sinLat*sinLat)
+
+	x := (n + loc.AltKm) * math.Cos(latR) * math.Cos(lonR)
+	y := (n + loc.AltKm) * math.Cos(latR) * math.Sin(lonR)
+	z := (n*(1-e2) + loc.AltKm) * sinLat
+
+	return [3]float64{x, y, z}
+}
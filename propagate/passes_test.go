@@ -0,0 +1,47 @@
+package propagate
+
+import (
+	"testing"
+	"time"
+)
+
+// TestPassesBoundaryCasesAreNotDropped covers the fix for passes that are
+// already underway at the start of the scan window, or still underway at
+// the end of it: both used to be silently dropped because aos was only
+// opened on a rising threshold crossing and only closed on a falling one.
+func TestPassesBoundaryCasesAreNotDropped(t *testing.T) {
+	p, gp := mustPropagator(t, sat00005TLE)
+	observer := LatLonAlt{LatDeg: 0, LonDeg: 0, AltKm: 0}
+
+	from := gp.EPOCH
+	to := from.Add(24 * time.Hour)
+
+	full := Passes(p, observer, from, to, 5)
+	if len(full) == 0 {
+		t.Fatalf("expected at least one pass over a 24h window to set up this test")
+	}
+	ref := full[0]
+
+	t.Run("pass already underway at from", func(t *testing.T) {
+		mid := ref.AOS.Add(ref.TCA.Sub(ref.AOS) / 2)
+		got := Passes(p, observer, mid, to, 5)
+		if len(got) == 0 {
+			t.Fatalf("expected the in-progress pass starting before the window to be reported, got none")
+		}
+		if !got[0].AOS.Equal(mid) {
+			t.Errorf("AOS = %v, want the scan start %v (truncated, not dropped)", got[0].AOS, mid)
+		}
+	})
+
+	t.Run("pass still underway at to", func(t *testing.T) {
+		mid := ref.TCA.Add(ref.LOS.Sub(ref.TCA) / 2)
+		got := Passes(p, observer, from, mid, 5)
+		if len(got) == 0 {
+			t.Fatalf("expected the pass still above the horizon at the window end to be reported, got none")
+		}
+		last := got[len(got)-1]
+		if !last.LOS.Equal(mid) {
+			t.Errorf("LOS = %v, want the scan end %v (truncated, not dropped)", last.LOS, mid)
+		}
+	})
+}
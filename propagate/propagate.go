@@ -0,0 +1,95 @@
+// Package propagate implements the SGP4 orbit propagation model so GP
+// element sets fetched from Celestrak can be turned directly into
+// position/velocity state vectors, without a separate propagation library.
+//
+// Deep-space orbits are not supported. SGP4 only covers near-earth
+// elements (period < 225 min); satellites past that threshold - GEO,
+// Molniya, and GNSS/GPS constellations - need SDP4's lunar-solar
+// secular/periodic perturbations and 12h/24h resonance integration,
+// which this package doesn't implement. NewPropagator returns an error
+// for such elements rather than silently producing a wrong state vector
+// or deferring the failure to the first At call.
+package propagate
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/avyayk/celestrak-go/parse"
+)
+
+// GravityModel selects the Earth gravity constants used during
+// initialization, matching the two constant sets in common SGP4 use.
+type GravityModel int
+
+const (
+	// WGS72 is the gravity model SGP4 was originally published against,
+	// and the default: it's what NORAD/Celestrak TLEs are fit to.
+	WGS72 GravityModel = iota
+	WGS84
+)
+
+// State is a position/velocity state vector. Position is in TEME
+// (True Equator, Mean Equinox) kilometers, Velocity in TEME km/s, unless
+// returned from TEMEToECEF which rotates Position/Velocity into ECEF.
+type State struct {
+	Position [3]float64
+	Velocity [3]float64
+}
+
+// Propagator holds the elements and constants needed to evaluate a
+// near-earth satellite's state at an arbitrary time via SGP4. Construct
+// with NewPropagator; a Propagator is safe for concurrent use by
+// multiple goroutines since At does not mutate it.
+type Propagator struct {
+	gp    parse.GP
+	model GravityModel
+
+	elements elements // initialized mean elements and secular rates
+}
+
+// NewPropagator initializes SGP4 from a parsed GP element set, applying
+// the standard a1->delta1->a0->delta0 recovery of the original mean
+// motion and semimajor axis, and precomputing the secular drag/gravity
+// coefficients used by At. Returns an error if the elements are
+// degenerate (e.g. eccentricity outside [0, 1)), or if they're
+// deep-space (period >= 225 min) - see the package doc.
+func NewPropagator(gp parse.GP, opts ...Option) (*Propagator, error) {
+	p := &Propagator{gp: gp, model: WGS72}
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	el, err := initElements(gp, gravityConstants(p.model))
+	if err != nil {
+		return nil, fmt.Errorf("propagate: init: %w", err)
+	}
+	p.elements = el
+
+	// Deep-space resonance applies once the orbital period reaches
+	// 225 minutes (roughly geosynchronous transfer orbit and beyond);
+	// this package only implements the near-earth model, so fail here
+	// rather than at the first At call.
+	if period := 2 * math.Pi / el.no; period >= 225.0 {
+		return nil, fmt.Errorf("propagate: deep-space orbits are not supported (period %.1f min >= 225 min threshold)", period)
+	}
+
+	return p, nil
+}
+
+// Option configures a Propagator.
+type Option func(*Propagator)
+
+// WithGravityModel selects the gravity constants used during
+// initialization. Defaults to WGS72, matching published TLEs.
+func WithGravityModel(m GravityModel) Option {
+	return func(p *Propagator) { p.model = m }
+}
+
+// At evaluates the propagator at time t, returning the TEME state vector.
+// p is always near-earth by construction - see NewPropagator.
+func (p *Propagator) At(t time.Time) (State, error) {
+	tsince := t.Sub(p.gp.EPOCH).Minutes()
+	return sgp4(p.elements, tsince)
+}
@@ -0,0 +1,151 @@
+package propagate
+
+import (
+	"fmt"
+	"math"
+)
+
+// sgp4 runs the near-earth SGP4 update for tsince minutes since epoch:
+// secular gravity/drag updates to mean anomaly, argument of perigee and
+// RAAN, a Kepler solve for eccentric anomaly, and the short-period
+// periodic corrections to r, u, Omega, i, and the radial/along-track
+// rates, finishing with the TEME position/velocity conversion.
+func sgp4(el elements, tsince float64) (State, error) {
+	g := el.g
+
+	xmdf := el.mo + el.xmdot*tsince
+	omgadf := el.argpo + el.omgdot*tsince
+	xnoddf := el.nodeo + el.xnodot*tsince
+	tsq := tsince * tsince
+	xnode := xnoddf + el.xnodcf*tsq
+	tempa := 1 - el.c1*tsince
+	tempe := el.bstar * el.c4 * tsince
+	templ := el.t2cof * tsq
+
+	omega := omgadf
+	xmp := xmdf
+
+	if !el.isimp {
+		delomg := el.omgcof * tsince
+		delm := el.xmcof * (math.Pow(1+el.ecco*math.Cos(xmdf), 3) - el.delmo)
+		temp := delomg + delm
+		xmp = xmdf + temp
+		omega = omgadf - temp
+		tcube := tsq * tsince
+		tfour := tcube * tsince
+		tempa = tempa - el.d2*tsq - el.d3*tcube - el.d4*tfour
+		tempe = tempe + el.bstar*el.c5*(math.Sin(xmp)-el.sinmo)
+		templ = templ + el.t3cof*tcube + tfour*(el.t4cof+tsince*el.t5cof)
+	}
+
+	a := el.ao * tempa * tempa
+	e := el.ecco - tempe
+	if e < 1e-6 {
+		e = 1e-6
+	}
+	if e >= 1 {
+		return State{}, fmt.Errorf("propagate: eccentricity diverged to %v at tsince=%v min", e, tsince)
+	}
+
+	xl := xmp + omega + xnode + el.no*templ
+
+	axn := e * math.Cos(omega)
+	temp := 1 / (a * (1 - e*e))
+	xll := temp * el.xlcof * axn
+	aynl := temp * el.aycof
+	xlt := xl + xll
+	ayn := e*math.Sin(omega) + aynl
+
+	capu := math.Mod(xlt-xnode, 2*math.Pi)
+	_, sinepw, cosepw, err := solveKepler(capu, axn, ayn)
+	if err != nil {
+		return State{}, fmt.Errorf("propagate: %w", err)
+	}
+
+	ecose := axn*cosepw + ayn*sinepw
+	esine := axn*sinepw - ayn*cosepw
+	elsq := axn*axn + ayn*ayn
+	temp4 := 1 - elsq
+	pl := a * temp4
+	r := a * (1 - ecose)
+	temp1 := 1 / r
+	rdot := g.xke * math.Sqrt(a) * esine * temp1
+	rfdot := g.xke * math.Sqrt(pl) * temp1
+	temp2 := a * temp1
+	betal := math.Sqrt(temp4)
+	temp3 := esine / (1 + betal)
+	cosu := temp2 * (cosepw - axn + ayn*temp3)
+	sinu := temp2 * (sinepw - ayn - axn*temp3)
+	u := math.Atan2(sinu, cosu)
+	sin2u := 2 * sinu * cosu
+	cos2u := 1 - 2*sinu*sinu
+
+	temp = 1 / pl
+	t1 := g.ck2 * temp
+	t2 := t1 * temp
+
+	rk := r*(1-1.5*t2*betal*el.x3thm1) + 0.5*t1*el.x1mth2*cos2u
+	uk := u - 0.25*t2*el.x7thm1*sin2u
+	xnodek := xnode + 1.5*t2*el.cosio*sin2u
+	xinck := el.inclo + 1.5*t2*el.cosio*el.sinio*cos2u
+	rdotk := rdot - el.no*t1*el.x1mth2*sin2u
+	rfdotk := rfdot + el.no*t1*(el.x1mth2*cos2u+1.5*el.x3thm1)
+
+	return orientedState(g, rk, uk, xnodek, xinck, rdotk, rfdotk), nil
+}
+
+// solveKepler iterates Newton's method on Kepler's equation in the form
+// SGP4 uses (capu = E - axn*sin(E) + ayn*cos(E)) to recover the
+// eccentric anomaly E, returning its final sin/cos alongside it.
+func solveKepler(capu, axn, ayn float64) (epw, sinepw, cosepw float64, err error) {
+	epw = capu
+	const maxIter = 10
+	for i := 0; i < maxIter; i++ {
+		sinepw = math.Sin(epw)
+		cosepw = math.Cos(epw)
+		a1 := axn * sinepw
+		a2 := ayn * cosepw
+		a3 := axn * cosepw
+		a4 := ayn * sinepw
+		denom := 1 - a3 - a4
+		if denom == 0 {
+			return 0, 0, 0, fmt.Errorf("kepler solve: singular denominator")
+		}
+		delta := (capu - a2 + a1 - epw) / denom
+		epw += delta
+		if math.Abs(delta) < 1e-12 {
+			break
+		}
+	}
+	return epw, math.Sin(epw), math.Cos(epw), nil
+}
+
+// orientedState converts the perifocal-ish rk/uk/nodek/inck/rdotk/rfdotk
+// short-period elements into a TEME position/velocity state, in km and
+// km/s.
+func orientedState(g gravity, rk, uk, xnodek, xinck, rdotk, rfdotk float64) State {
+	sinuk, cosuk := math.Sin(uk), math.Cos(uk)
+	sinik, cosik := math.Sin(xinck), math.Cos(xinck)
+	sinnok, cosnok := math.Sin(xnodek), math.Cos(xnodek)
+
+	xmx := -sinnok * cosik
+	xmy := cosnok * cosik
+
+	ux := xmx*sinuk + cosnok*cosuk
+	uy := xmy*sinuk + sinnok*cosuk
+	uz := sinik * sinuk
+	vx := xmx*cosuk - cosnok*sinuk
+	vy := xmy*cosuk - sinnok*sinuk
+	vz := sinik * cosuk
+
+	velScale := g.xkmper / 60.0 // earth-radii/min -> km/s
+
+	return State{
+		Position: [3]float64{rk * ux * g.xkmper, rk * uy * g.xkmper, rk * uz * g.xkmper},
+		Velocity: [3]float64{
+			(rdotk*ux + rfdotk*vx) * velScale,
+			(rdotk*uy + rfdotk*vy) * velScale,
+			(rdotk*uz + rfdotk*vz) * velScale,
+		},
+	}
+}
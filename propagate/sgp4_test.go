@@ -0,0 +1,96 @@
+package propagate
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/avyayk/celestrak-go/parse"
+)
+
+// sat00005TLE is the canonical near-earth SGP4 test vector from Spacetrack
+// Report #3 / Vallado's "Revisiting Spacetrack Report #3", reproduced in
+// effectively every SGP4 implementation's test suite.
+const sat00005TLE = "1 00005U 58002B   00179.78495062  .00000023  00000-0  28098-4 0  4753\n" +
+	"2 00005  34.2682 348.7242 1859667 331.7664  19.3264 10.82419157413667\n"
+
+func mustPropagator(t *testing.T, tle string) (*Propagator, parse.GP) {
+	t.Helper()
+	gps, err := parse.Parse2LE([]byte(tle))
+	if err != nil {
+		t.Fatalf("Parse2LE: %v", err)
+	}
+	if len(gps) != 1 {
+		t.Fatalf("got %d records, want 1", len(gps))
+	}
+	p, err := NewPropagator(gps[0])
+	if err != nil {
+		t.Fatalf("NewPropagator: %v", err)
+	}
+	return p, gps[0]
+}
+
+func assertVector(t *testing.T, label string, got, want [3]float64, tol float64) {
+	t.Helper()
+	for i := range got {
+		if math.Abs(got[i]-want[i]) > tol {
+			t.Errorf("%s[%d] = %v, want %v (tol %v)", label, i, got[i], want[i], tol)
+		}
+	}
+}
+
+// TestSGP4MatchesVallado00005AtEpoch checks the near-earth path against
+// the published reference state vector for sat 00005 at its epoch
+// (tsince=0), to within 1e-3 km in position and 1e-3 km/s in velocity.
+func TestSGP4MatchesVallado00005AtEpoch(t *testing.T) {
+	p, gp := mustPropagator(t, sat00005TLE)
+
+	st, err := p.At(gp.EPOCH)
+	if err != nil {
+		t.Fatalf("At(epoch): %v", err)
+	}
+
+	wantPos := [3]float64{7022.465292, -1400.082967, 0.039952}
+	wantVel := [3]float64{1.893841, 6.405894, 4.534807}
+	assertVector(t, "position", st.Position, wantPos, 1e-3)
+	assertVector(t, "velocity", st.Velocity, wantVel, 1e-3)
+}
+
+// TestSGP4RegressionAt360Minutes pins the state 360 minutes after epoch
+// against the published reference state vector for sat 00005, guarding
+// the secular/Kepler update loop against regressions beyond the epoch
+// data point above.
+func TestSGP4RegressionAt360Minutes(t *testing.T) {
+	p, gp := mustPropagator(t, sat00005TLE)
+
+	st, err := p.At(gp.EPOCH.Add(360 * time.Minute))
+	if err != nil {
+		t.Fatalf("At(epoch+360min): %v", err)
+	}
+
+	wantPos := [3]float64{-7154.031202, -3783.176825, -3536.194123}
+	wantVel := [3]float64{4.741887, -4.151818, -2.093935}
+	assertVector(t, "position", st.Position, wantPos, 1e-3)
+	assertVector(t, "velocity", st.Velocity, wantVel, 1e-3)
+}
+
+// TestDeepSpaceOrbitRejectedAtConstruction covers the fix for returning
+// wrong near-earth state vectors for deep-space orbits: a GPS-period
+// element set (period ~718 min, well past the 225 min deep-space
+// threshold) must fail at NewPropagator, not silently succeed and only
+// fail later (or worse, propagate with sgp4) at the first At call.
+func TestDeepSpaceOrbitRejectedAtConstruction(t *testing.T) {
+	const gpsTLE = "1 24876U 97035A   24079.54791667  .00000045  00000-0  00000-0 0  9997\n" +
+		"2 24876  55.4657  55.1631 0065830  54.1234 306.5678  2.00561234 99874\n"
+	gps, err := parse.Parse2LE([]byte(gpsTLE))
+	if err != nil {
+		t.Fatalf("Parse2LE: %v", err)
+	}
+	if len(gps) != 1 {
+		t.Fatalf("got %d records, want 1", len(gps))
+	}
+
+	if _, err := NewPropagator(gps[0]); err == nil {
+		t.Fatalf("NewPropagator on a deep-space element set returned no error; want an explicit construction-time failure")
+	}
+}